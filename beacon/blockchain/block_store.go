@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// rebuildBlockStore indexes the blocks whose state is still retained by the
+// consensus engine but which are missing from the block store, e.g. after
+// the block store database was removed. Slots are indexed backwards from the
+// earliest indexed slot until the historical states are no longer available.
+//
+// NOTE: this relies on slots and heights being equal, as enforced when
+// building blocks.
+func (s *Service) rebuildBlockStore(ctx context.Context) {
+	if s.stateHistory == nil {
+		return
+	}
+	blockStore := s.storageBackend.BlockStore()
+	latest := s.stateHistory.LastBlockHeight()
+	if latest <= 0 {
+		return
+	}
+
+	//#nosec: G115 // heights are positive.
+	start := math.Slot(latest)
+	earliest, ok, err := blockStore.EarliestSlot()
+	if err != nil {
+		s.logger.Error("Failed to read the earliest indexed slot", "error", err)
+		return
+	}
+	if ok {
+		if earliest <= 1 {
+			return
+		}
+		start = earliest - 1
+	}
+
+	var (
+		// next is the state of the slot following the one being indexed,
+		// which holds the block and state roots of the indexed slot.
+		next    *statedb.StateDB
+		indexed uint64
+	)
+	if start < math.Slot(latest) {
+		if next, err = s.stateAtSlot(start + 1); err != nil {
+			s.logger.Debug("No historical state to rebuild block indices", "error", err)
+			return
+		}
+	}
+
+	for slot := start; slot > 0; slot-- {
+		if ctx.Err() != nil {
+			return
+		}
+		st, stErr := s.stateAtSlot(slot)
+		if stErr != nil {
+			// The historical state has been pruned.
+			break
+		}
+		if err = s.indexSlot(slot, st, next); err != nil {
+			s.logger.Error("Failed to index historical block", "slot", slot, "error", err)
+			return
+		}
+		next = st
+		indexed++
+	}
+
+	if indexed > 0 {
+		s.logger.Info(
+			"Rebuilt block store indices from historical states",
+			"slots", indexed, "earliest", start-math.Slot(indexed)+1,
+		)
+	}
+}
+
+// indexSlot stores the block root, state root and timestamp of the slot in
+// the block store. The roots are read from the state of the next slot if
+// available, otherwise they are computed from the state of the slot itself.
+func (s *Service) indexSlot(slot math.Slot, st, next *statedb.StateDB) error {
+	var blockRoot, stateRoot common.Root
+	if next != nil {
+		idx := slot.Unwrap() % s.chainSpec.SlotsPerHistoricalRoot()
+		var err error
+		if blockRoot, err = next.GetBlockRootAtIndex(idx); err != nil {
+			return err
+		}
+		if stateRoot, err = next.StateRootAtIndex(idx); err != nil {
+			return err
+		}
+	} else {
+		header, err := st.GetLatestBlockHeader()
+		if err != nil {
+			return err
+		}
+		stateRoot = st.HashTreeRoot()
+		header.SetStateRoot(stateRoot)
+		blockRoot = header.HashTreeRoot()
+	}
+
+	payloadHeader, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+	return s.storageBackend.BlockStore().Index(
+		slot, blockRoot, payloadHeader.GetTimestamp(), stateRoot,
+	)
+}
+
+// stateAtSlot returns the historical state committed at the given slot.
+func (s *Service) stateAtSlot(slot math.Slot) (*statedb.StateDB, error) {
+	//#nosec: G115 // slots fit in an int64 in practice.
+	queryCtx, err := s.stateHistory.CreateQueryContext(int64(slot), false)
+	if err != nil {
+		return nil, err
+	}
+	st := s.storageBackend.StateFromContext(queryCtx)
+	stSlot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	if stSlot != slot {
+		return nil, fmt.Errorf("state at height %d has slot %d", slot, stSlot)
+	}
+	return st, nil
+}
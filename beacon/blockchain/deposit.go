@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
 // defaultRetryInterval processes a deposit event.
@@ -35,8 +36,13 @@ const defaultRetryInterval = 20 * time.Second
 
 func (s *Service) depositFetcher(
 	ctx context.Context,
+	st *statedb.StateDB,
 	blockNum math.U64,
 ) {
+	if s.checkLegacyDepositsDrained(st) {
+		return
+	}
+
 	if blockNum <= s.eth1FollowDistance {
 		s.logger.Info(
 			"depositFetcher, nothing to fetch",
@@ -49,6 +55,26 @@ func (s *Service) depositFetcher(
 	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
 }
 
+// checkLegacyDepositsDrained returns whether all the deposits preceding the
+// first EIP-6110 deposit request have been processed in the given state. Once
+// they are, deposits are read from the payload's execution requests and the
+// deposit contract logs do not need to be fetched nor retried anymore.
+func (s *Service) checkLegacyDepositsDrained(st *statedb.StateDB) bool {
+	drained, err := st.LegacyDepositsDrained()
+	if err != nil {
+		s.logger.Error("Failed to check legacy deposits drained", "error", err)
+		return false
+	}
+	if !drained {
+		return false
+	}
+
+	s.failedBlocksMu.Lock()
+	clear(s.failedBlocks)
+	s.failedBlocksMu.Unlock()
+	return true
+}
+
 // fetchAndStoreDeposits processes all deposits at a particular EL block height.
 // TODO: This could be optimized to process a contiguous range of blocks simultaneously to minimize EL RPC calls.
 func (s *Service) fetchAndStoreDeposits(
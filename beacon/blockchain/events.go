@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// publishFinalizedBlockEvents publishes the events of a block that has just
+// been finalized to the node API event stream. Since CometBFT provides single
+// slot finality, the finalized block is also the new head of the chain.
+func (s *Service) publishFinalizedBlockEvents(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	sidecars datypes.BlobSidecars,
+	consensusTime math.U64,
+) {
+	var (
+		slot            = blk.GetSlot()
+		blockRoot       = blk.HashTreeRoot()
+		stateRoot       = blk.GetStateRoot()
+		epochTransition = slot.Unwrap()%s.chainSpec.SlotsPerEpoch() == 0
+	)
+
+	s.eventPublisher.Publish(events.New(events.TopicBlock, &events.BlockData{
+		Slot:  slot.Unwrap(),
+		Block: blockRoot,
+	}))
+
+	for _, sidecar := range sidecars {
+		s.eventPublisher.Publish(events.New(
+			events.TopicBlobSidecar,
+			events.BlobSidecarDataFromConsensus(blockRoot, sidecar),
+		))
+	}
+
+	s.eventPublisher.Publish(events.New(events.TopicHead, &events.HeadData{
+		Slot:                      slot.Unwrap(),
+		Block:                     blockRoot,
+		State:                     stateRoot,
+		EpochTransition:           epochTransition,
+		PreviousDutyDependentRoot: blk.GetParentBlockRoot(),
+		CurrentDutyDependentRoot:  blk.GetParentBlockRoot(),
+	}))
+
+	// The first block of an epoch is the checkpoint of that epoch, which is
+	// finalized as soon as the block is.
+	if epochTransition {
+		s.eventPublisher.Publish(events.New(
+			events.TopicFinalizedCheckpoint,
+			&events.FinalizedCheckpointData{
+				Block: blockRoot,
+				State: stateRoot,
+				Epoch: s.chainSpec.SlotToEpoch(slot).Unwrap(),
+			},
+		))
+	}
+
+	// Building the payload attributes requires processing the next slot on
+	// a copy of the state, so we only do it if someone is listening.
+	if !s.eventPublisher.HasSubscribers(events.TopicPayloadAttributes) {
+		return
+	}
+	if err := s.publishPayloadAttributes(
+		ctx, st, blk, consensusTime,
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish payload attributes event",
+			"for_slot", (slot + 1).Base10(),
+			"error", err,
+		)
+	}
+}
+
+// publishPayloadAttributes publishes the payload attributes this node would
+// use to build the payload of the block following the given one.
+func (s *Service) publishPayloadAttributes(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	consensusTime math.U64,
+) error {
+	var (
+		slot      = blk.GetSlot() + 1
+		blockRoot = blk.HashTreeRoot()
+		payload   = blk.GetBody().GetExecutionPayload()
+		timestamp = payloadtime.Next(
+			consensusTime,
+			payload.GetTimestamp(),
+			true, // buildOptimistically
+		)
+	)
+
+	// Prepare a copy of the state for the next slot, as done when building
+	// a payload for it.
+	nextSt := st.Copy(ctx)
+	if _, err := s.stateProcessor.ProcessSlots(nextSt, slot); err != nil {
+		return err
+	}
+	if err := s.stateProcessor.ProcessFork(nextSt, timestamp, false); err != nil {
+		return err
+	}
+
+	attrs, err := s.attributesFactory.BuildPayloadAttributes(
+		nextSt, slot, timestamp, blockRoot,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.eventPublisher.Publish(events.New(
+		events.TopicPayloadAttributes,
+		&events.PayloadAttributesEvent{
+			Version: version.Name(
+				s.chainSpec.ActiveForkVersionForTimestamp(timestamp),
+			),
+			Data: &events.PayloadAttributesData{
+				ProposalSlot:      slot.Unwrap(),
+				ParentBlockNumber: payload.GetNumber().Unwrap(),
+				ParentBlockRoot:   blockRoot,
+				ParentBlockHash:   payload.GetBlockHash(),
+				PayloadAttributes: events.PayloadAttributesFromEngine(attrs),
+			},
+		},
+	))
+	return nil
+}
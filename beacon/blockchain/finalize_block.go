@@ -97,25 +97,41 @@ func (s *Service) FinalizeBlock(
 	}
 
 	// STEP 3: Finalize the block.
-	consensusBlk := types.NewConsensusBlock(blk, req.GetProposerAddress(), req.GetTime())
+	consensusBlk := types.NewConsensusBlock(
+		blk,
+		req.GetProposerAddress(),
+		req.GetTime(),
+		encoding.ExtractMisbehaviorsFromRequest(req),
+		encoding.ExtractVotes(req.GetDecidedLastCommit().Votes),
+	)
+	// If the block was accepted in ProcessProposal, consensus finalizes it on
+	// top of its post-state, so the state transition is not executed again.
 	st := s.storageBackend.StateFromContext(ctx)
-	valUpdates, err := s.finalizeBeaconBlock(ctx, st, consensusBlk)
-	if err != nil {
-		s.logger.Error("Failed to process verified beacon block",
-			"error", err,
-		)
-		return nil, err
+	valUpdates, cached := s.cachedValidatorUpdates(st, req.GetHeight(), req.GetHash(), blk.GetSlot())
+	if cached {
+		s.metrics.markProposalResultCacheHit()
+		// The transition of ProcessProposal does not meter gas, so record
+		// it here as finalizing the block would have.
+		s.stateProcessor.MeterGas(blk)
+	} else {
+		s.metrics.markProposalResultCacheMiss()
+		valUpdates, err = s.finalizeBeaconBlock(ctx, st, consensusBlk)
+		if err != nil {
+			s.logger.Error("Failed to process verified beacon block",
+				"error", err,
+			)
+			return nil, err
+		}
 	}
 
 	// STEP 4: Post Finalizations cleanups.
 
-	// Fetch and store the deposit for the block.
+	// Fetch and store the deposit for the block, until deposits are only
+	// processed from the execution requests.
 	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
-	s.depositFetcher(ctx, blockNum)
+	s.depositFetcher(ctx, st, blockNum)
 
 	// Store the finalized block in the KVStore.
-	//
-	// TODO: Store full SignedBeaconBlock with all data in storage
 	slot := blk.GetSlot()
 	if err = s.storageBackend.BlockStore().Set(blk); err != nil {
 		s.logger.Error(
@@ -124,6 +140,14 @@ func (s *Service) FinalizeBlock(
 		return nil, err
 	}
 
+	// Store the full signed block to serve it through the node API.
+	if err = s.storageBackend.SignedBlockStore().Set(ctx, signedBlk); err != nil {
+		s.logger.Error(
+			"failed to store signed block", "slot", slot, "error", err,
+		)
+		return nil, err
+	}
+
 	// Prune the availability and deposit store.
 	err = s.processPruning(ctx, blk)
 	if err != nil {
@@ -134,6 +158,11 @@ func (s *Service) FinalizeBlock(
 		return nil, fmt.Errorf("sendPostBlockFCU failed: %w", err)
 	}
 
+	// STEP 5: Notify the subscribers of the node API event stream.
+	s.publishFinalizedBlockEvents(
+		ctx, st, blk, blobs, math.U64(req.GetTime().Unix()), //#nosec: G115
+	)
+
 	return valUpdates, nil
 }
 
@@ -151,24 +180,26 @@ func (s *Service) finalizeBeaconBlock(
 		return nil, ErrNilBlk
 	}
 
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	valUpdates, err := s.executeStateTransition(ctx, st, blk, true)
 	if err != nil {
 		return nil, err
 	}
 	return valUpdates.CanonicalSort(), nil
 }
 
-// executeStateTransition runs the stf.
+// executeStateTransition runs the stf on a block finalized by consensus, when
+// finalizing or replaying it.
 func (s *Service) executeStateTransition(
 	ctx context.Context,
 	st *statedb.StateDB,
 	blk *types.ConsensusBlock,
+	verifyPayload bool,
 ) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
 
 	// Notes about context attributes:
-	// - VerifyPayload: set to true. When we are NOT synced to the tip,
+	// - VerifyPayload: set to true in FinalizeBlock. When we are NOT synced to the tip,
 	// process proposal does NOT get called and thus we must ensure that
 	// NewPayload is called to get the execution client the payload.
 	// When we are synced to the tip, we can skip the
@@ -177,18 +208,25 @@ func (s *Service) executeStateTransition(
 	// In both cases the payload was already accepted by a majority
 	// of validators in their process proposal call and thus
 	// the "verification aspect" of this NewPayload call is
-	// actually irrelevant at this point.
+	// actually irrelevant at this point. A replay only verifies the payload
+	// on demand, as the execution client may not be running.
 	// - VerifyRandao: set to false. We skip randao validation in FinalizeBlock
 	// since either
 	//   1. we validated it during ProcessProposal at the head of the chain OR
 	//   2. we are bootstrapping and implicitly trust that the randao was validated by
 	//    the super majority during ProcessProposal of the given block height.
+	// - Misbehaviors: the evidence committed to by consensus for this block,
+	// which is slashed as part of the state transition.
+	// - LastCommitVotes: the signatures on the previous block committed to by
+	// consensus, used to track the liveness of validators.
 	txCtx := transition.NewTransitionCtx(
 		ctx,
 		blk.GetConsensusTime(),
 		blk.GetProposerAddress(),
 	).
-		WithVerifyPayload(true).
+		WithMisbehaviors(blk.GetMisbehaviors()).
+		WithLastCommitVotes(blk.GetLastCommitVotes()).
+		WithVerifyPayload(verifyPayload).
 		WithVerifyRandao(false).
 		WithVerifyResult(false).
 		WithMeterGas(true)
@@ -51,21 +51,25 @@ func (s *Service) ProcessGenesisData(
 		)
 	}
 
-	// Ensure consistency of the genesis fork version.
+	// Ensure consistency of the genesis fork version with the fork of the chain spec fork
+	// registry active at genesis.
 	genesisVersion := genesisData.GetForkVersion()
-	if !version.Equals(genesisVersion, s.chainSpec.GenesisForkVersion()) {
+	genesisFork := s.chainSpec.ActiveFork(execPayloadHeader.GetTimestamp())
+	if !version.Equals(genesisVersion, genesisFork.Version) {
 		return nil, fmt.Errorf(
-			"fork mismatch between CL genesis file version (%s) and chain spec genesis version (%s)",
-			genesisVersion, s.chainSpec.GenesisForkVersion(),
+			"fork mismatch between CL genesis file version (%s) and chain spec genesis fork %s (%s)",
+			genesisVersion, genesisFork.Name, genesisFork.Version,
 		)
 	}
 
-	// Initialize the beacon state from the genesis deposits.
+	// Initialize the beacon state from the genesis deposits, or from the
+	// restart state of an exported genesis.
 	validatorUpdates, err := s.stateProcessor.InitializeBeaconStateFromEth1(
 		s.storageBackend.StateFromContext(ctx),
 		genesisData.GetDeposits(),
 		execPayloadHeader,
 		genesisVersion,
+		genesisData.GetRestart(),
 	)
 	if err != nil {
 		return nil, err
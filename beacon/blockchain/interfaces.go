@@ -29,6 +29,7 @@ import (
 	dastore "github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
@@ -74,16 +75,38 @@ type LocalBuilder interface {
 	) (*engineprimitives.PayloadID, common.Version, error)
 }
 
+// AttributesFactory is the interface for the payload attributes factory.
+type AttributesFactory interface {
+	// BuildPayloadAttributes creates the payload attributes for the given
+	// slot and timestamp.
+	BuildPayloadAttributes(
+		st *statedb.StateDB,
+		slot math.Slot,
+		timestamp math.U64,
+		prevHeadRoot [32]byte,
+	) (*engineprimitives.PayloadAttributes, error)
+}
+
+// EventPublisher publishes chain events to the subscribers of the node API
+// event stream.
+type EventPublisher interface {
+	// Publish sends the event to all the subscribers of its topic.
+	Publish(event *events.Event)
+	// HasSubscribers returns true if the given topic has any subscriber.
+	HasSubscribers(topic string) bool
+}
+
 // StateProcessor defines the interface for processing various state transitions
 // in the beacon chain.
 type StateProcessor interface {
 	// InitializeBeaconStateFromEth1 initializes the premined beacon
-	// state from the eth1 deposits.
+	// state from the eth1 deposits, or from the state of an exported genesis.
 	InitializeBeaconStateFromEth1(
 		*statedb.StateDB,
 		ctypes.Deposits,
 		*ctypes.ExecutionPayloadHeader,
 		common.Version,
+		*ctypes.GenesisRestart,
 	) (transition.ValidatorUpdates, error)
 	// ProcessFork prepares the state for the fork version at the given timestamp.
 	ProcessFork(
@@ -99,12 +122,18 @@ type StateProcessor interface {
 		*statedb.StateDB,
 		*ctypes.BeaconBlock,
 	) (transition.ValidatorUpdates, error)
+	// MeterGas records the gas used by the execution payload of the block,
+	// as done by Transition when metering gas.
+	MeterGas(*ctypes.BeaconBlock)
 	GetSignatureVerifierFn(*statedb.StateDB) (
 		func(
 			blk *ctypes.BeaconBlock,
 			signature crypto.BLSSignature) error,
 		error,
 	)
+	// ValidateStatelessPayload performs the checks on the execution payload
+	// of a block which require neither the state nor the execution engine.
+	ValidateStatelessPayload(*ctypes.BeaconBlock) error
 }
 
 // StorageBackend defines an interface for accessing various storage components
@@ -118,6 +147,8 @@ type StorageBackend interface {
 	DepositStore() *depositdb.KVStore
 	// BlockStore retrieves the block store.
 	BlockStore() *block.KVStore[*ctypes.BeaconBlock]
+	// SignedBlockStore retrieves the store of full signed blocks.
+	SignedBlockStore() *block.SignedStore
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -145,6 +176,18 @@ type BlockchainI interface {
 	) (transition.ValidatorUpdates, error)
 }
 
+// BlockReplayer re-executes finalized blocks to rebuild the state and the
+// stores derived from the chain.
+type BlockReplayer interface {
+	// ReplayBlock re-executes the finalized block of the request on top of
+	// the state of the context.
+	ReplayBlock(
+		ctx sdk.Context,
+		req *cmtabci.FinalizeBlockRequest,
+		verifyPayload bool,
+	) error
+}
+
 // BlobProcessor is the interface for the blobs processor.
 type BlobProcessor interface {
 	// ProcessSidecars processes the blobs and ensures they match the local
@@ -172,4 +215,16 @@ type ServiceChainSpec interface {
 	chain.BlobSpec
 	chain.ForkSpec
 	chain.ForkVersionSpec
+	SlotToEpoch(slot math.Slot) math.Epoch
+	SlotsPerHistoricalRoot() uint64
+}
+
+// StateHistory provides access to the historical app states retained by the
+// consensus engine.
+type StateHistory interface {
+	// CreateQueryContext creates a context to query the state at the given
+	// height.
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+	// LastBlockHeight returns the last committed block height.
+	LastBlockHeight() int64
 }
@@ -116,3 +116,23 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markProposalResultCacheHit increments the counter for the number of blocks
+// finalized on top of the post-state computed in ProcessProposal.
+func (cm *chainMetrics) markProposalResultCacheHit() {
+	cm.sink.IncrementCounter("beacon_kit.blockchain.proposal_result_cache_hit")
+}
+
+// markProposalResultCacheMiss increments the counter for the number of blocks
+// whose state transition is executed again in FinalizeBlock.
+func (cm *chainMetrics) markProposalResultCacheMiss() {
+	cm.sink.IncrementCounter("beacon_kit.blockchain.proposal_result_cache_miss")
+}
+
+// markStatelessPayloadRejected increments the counter for the number of
+// proposals rejected by the stateless checks of their execution payload.
+func (cm *chainMetrics) markStatelessPayloadRejected(err error) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.stateless_payload_rejected", "error", err.Error(),
+	)
+}
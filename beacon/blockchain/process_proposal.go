@@ -115,6 +115,13 @@ func (s *Service) ProcessProposal(
 		)
 	}
 
+	// Reject malformed payloads early on, before the state is loaded and the
+	// execution client is contacted.
+	if err = s.stateProcessor.ValidateStatelessPayload(blk); err != nil {
+		s.metrics.markStatelessPayloadRejected(err)
+		return err
+	}
+
 	// Make sure we have the right number of BlobSidecars
 	blobKzgCommitments := blk.GetBody().GetBlobKzgCommitments()
 	numCommitments := len(blobKzgCommitments)
@@ -161,23 +168,36 @@ func (s *Service) ProcessProposal(
 		}
 	}
 
-	// Process the block.
+	// Process the block. Any post-state cached for a previous proposal is
+	// dropped first, as ProcessProposal may be called again in a later round.
+	s.proposalResult = nil
 	consensusBlk := types.NewConsensusBlock(
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		encoding.ExtractMisbehaviorsFromRequest(req),
+		encoding.ExtractVotes(req.GetProposedLastCommit().Votes),
 	)
-	err = s.VerifyIncomingBlock(
+	valUpdates, err := s.VerifyIncomingBlock(
 		ctx,
 		consensusBlk.GetBeaconBlock(),
 		consensusBlk.GetConsensusTime(),
 		consensusBlk.GetProposerAddress(),
+		consensusBlk.GetMisbehaviors(),
+		consensusBlk.GetLastCommitVotes(),
 	)
 	if err != nil {
 		s.logger.Error("failed to verify incoming block", "error", err)
 		return err
 	}
 
+	// The post-state of the block has been written to the ProcessProposal
+	// state, keep the result to finalize the block without executing it again.
+	s.proposalResult = &proposalResult{
+		height:     req.GetHeight(),
+		hash:       req.GetHash(),
+		valUpdates: valUpdates.CanonicalSort(),
+	}
 	return nil
 }
 
@@ -226,15 +246,18 @@ func (s *Service) VerifyIncomingBlobSidecars(
 }
 
 // VerifyIncomingBlock verifies the state root of an incoming block
-// and logs the process.
+// and logs the process. If the block is valid its post-state is written to the
+// state of the given context and the resulting validator updates are returned.
 //
 //nolint:funlen // not an issue
 func (s *Service) VerifyIncomingBlock(
-	ctx context.Context,
+	ctx sdk.Context,
 	beaconBlk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
-) error {
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
+) (transition.ValidatorUpdates, error) {
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.storageBackend.StateFromContext(ctx)
 
@@ -256,8 +279,10 @@ func (s *Service) VerifyIncomingBlock(
 	// We purposefully make a copy of the BeaconState in order
 	// to avoid modifying the underlying state, for the event in which
 	// we have to rebuild a payload for this slot again, if we do not agree
-	// with the incoming block.
-	postState := preState.Copy(ctx)
+	// with the incoming block. The copy is only written back once the block
+	// is verified.
+	postCtx, writePostState := ctx.CacheContext()
+	postState := s.storageBackend.StateFromContext(postCtx)
 
 	// verify block slot
 	stateSlot, err := postState.GetSlot()
@@ -266,7 +291,7 @@ func (s *Service) VerifyIncomingBlock(
 			"failed loading state slot to verify block slot",
 			"reason", err,
 		)
-		return err
+		return nil, err
 	}
 
 	blkSlot := beaconBlk.GetSlot()
@@ -277,16 +302,19 @@ func (s *Service) VerifyIncomingBlock(
 			"block slot", blkSlot.Base10(),
 			"reason", ErrUnexpectedBlockSlot.Error(),
 		)
-		return ErrUnexpectedBlockSlot
+		return nil, ErrUnexpectedBlockSlot
 	}
 
 	// Verify the state root of the incoming block.
-	err = s.verifyStateRoot(
+	valUpdates, err := s.verifyStateRoot(
 		ctx,
 		postState,
 		beaconBlk,
 		consensusTime,
-		proposerAddress)
+		proposerAddress,
+		misbehaviors,
+		lastCommitVotes,
+	)
 	if err != nil {
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
@@ -297,7 +325,7 @@ func (s *Service) VerifyIncomingBlock(
 		if s.shouldBuildOptimisticPayloads() {
 			lph, lphErr := preState.GetLatestExecutionPayloadHeader()
 			if lphErr != nil {
-				return errors.Join(
+				return nil, errors.Join(
 					err,
 					fmt.Errorf("failed getting LatestExecutionPayloadHeader: %w", lphErr),
 				)
@@ -316,7 +344,7 @@ func (s *Service) VerifyIncomingBlock(
 			)
 		}
 
-		return err
+		return nil, err
 	}
 
 	s.logger.Info(
@@ -325,15 +353,28 @@ func (s *Service) VerifyIncomingBlock(
 		beaconBlk.GetStateRoot(),
 	)
 
+	// Write the verified state back before building optimistically, so that
+	// FinalizeBlock commits exactly the state verified here.
+	writePostState()
+
 	if s.shouldBuildOptimisticPayloads() {
 		lph, lphErr := postState.GetLatestExecutionPayloadHeader()
 		if lphErr != nil {
-			return fmt.Errorf("failed loading LatestExecutionPayloadHeader: %w", lphErr)
+			return nil, fmt.Errorf("failed loading LatestExecutionPayloadHeader: %w", lphErr)
 		}
 
+		// The optimistic build processes the next slot on its own snapshot
+		// of the state, detached from the multistore FinalizeBlock commits.
+		buildState, snapErr := postState.Snapshot()
+		if snapErr != nil {
+			s.logger.Error(
+				"Failed to snapshot state for optimistic payload build", "error", snapErr,
+			)
+			return valUpdates, nil
+		}
 		go s.handleOptimisticPayloadBuild(
 			ctx,
-			postState,
+			buildState,
 			beaconBlk,
 			payloadtime.Next(
 				consensusTime,
@@ -343,7 +384,7 @@ func (s *Service) VerifyIncomingBlock(
 		)
 	}
 
-	return nil
+	return valUpdates, nil
 }
 
 // verifyStateRoot verifies the state root of an incoming block.
@@ -353,7 +394,9 @@ func (s *Service) verifyStateRoot(
 	blk *ctypes.BeaconBlock,
 	consensusTime math.U64,
 	proposerAddress []byte,
-) error {
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
+) (transition.ValidatorUpdates, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
 
@@ -362,13 +405,14 @@ func (s *Service) verifyStateRoot(
 		consensusTime,
 		proposerAddress,
 	).
+		WithMisbehaviors(misbehaviors).
+		WithLastCommitVotes(lastCommitVotes).
 		WithVerifyPayload(true).
 		WithVerifyRandao(true).
 		WithVerifyResult(true).
 		WithMeterGas(false)
 
-	_, err := s.stateProcessor.Transition(txCtx, st, blk)
-	return err
+	return s.stateProcessor.Transition(txCtx, st, blk)
 }
 
 // shouldBuildOptimisticPayloads returns true if optimistic
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"bytes"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// proposalResult is the result of the state transition of the last block
+// accepted in ProcessProposal, whose post-state has been written to the
+// ProcessProposal state.
+type proposalResult struct {
+	// height is the height of the accepted block.
+	height int64
+	// hash is the hash of the accepted block.
+	hash []byte
+	// valUpdates are the validator updates of the state transition.
+	valUpdates transition.ValidatorUpdates
+}
+
+// cachedValidatorUpdates returns the validator updates of the block with the
+// given height, hash and slot, if it was accepted in ProcessProposal and the given
+// state is the post-state of its transition. The block hash commits to all of
+// the inputs of the state transition, so that the post-state computed in
+// ProcessProposal is the one FinalizeBlock would compute.
+//
+// NOTE: the ProcessProposal state is reused by consensus to finalize the block
+// it accepted, except on the initial height. In that case the given state is
+// the pre-state and the block must be executed again.
+func (s *Service) cachedValidatorUpdates(
+	st *statedb.StateDB, height int64, hash []byte, slot math.Slot,
+) (transition.ValidatorUpdates, bool) {
+	res := s.proposalResult
+	s.proposalResult = nil
+	if res == nil || res.height != height || !bytes.Equal(res.hash, hash) {
+		return nil, false
+	}
+
+	// The state slot is the block slot only once the block has been applied.
+	stateSlot, err := st.GetSlot()
+	if err != nil || stateSlot != slot {
+		return nil, false
+	}
+	return res.valUpdates, true
+}
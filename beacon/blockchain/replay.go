@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReplayBlock re-executes a finalized block on top of the state of the given
+// context, rebuilding the stores FinalizeBlock writes along the way: blob
+// sidecars within the DA period, deposits and block indices. Unlike
+// FinalizeBlock, it neither sends forkchoice updates to the execution client
+// nor publishes events. The payload is verified by the execution client only
+// if verifyPayload is set, otherwise it is trusted as finalized by consensus.
+func (s *Service) ReplayBlock(
+	ctx sdk.Context,
+	req *cmtabci.FinalizeBlockRequest,
+	verifyPayload bool,
+) error {
+	// STEP 1: Decode block and blobs.
+	forkVersion := s.chainSpec.ActiveForkVersionForTimestamp(math.U64(req.GetTime().Unix())) //#nosec: G115
+	signedBlk, blobs, err := encoding.ExtractBlobsAndBlockFromRequest(
+		req,
+		BeaconBlockTxIndex,
+		BlobSidecarsTxIndex,
+		forkVersion,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to decode block and blobs: %w", err)
+	}
+	blk := signedBlk.GetBeaconBlock()
+
+	// STEP 2: Store the sidecars within the DA period of the replay target.
+	//
+	//#nosec: G115 // SyncingToHeight will never be negative.
+	if s.chainSpec.WithinDAPeriod(blk.GetSlot(), math.Slot(req.SyncingToHeight)) {
+		if err = s.blobProcessor.ProcessSidecars(
+			s.storageBackend.AvailabilityStore(), blobs,
+		); err != nil {
+			return fmt.Errorf("failed to process blob sidecars: %w", err)
+		}
+	}
+
+	// STEP 3: Store the block deposits missing from the deposit store, which
+	// the state transition validates the block deposits against. The deposits
+	// already stored, read from the deposit contract logs, are kept as the
+	// reference, while the missing ones are trusted as finalized by consensus.
+	if err = s.storeMissingDeposits(ctx, blk.GetBody().GetDeposits()); err != nil {
+		return fmt.Errorf("failed to store deposits: %w", err)
+	}
+
+	// STEP 4: Execute the state transition as FinalizeBlock does.
+	consensusBlk := types.NewConsensusBlock(
+		blk,
+		req.GetProposerAddress(),
+		req.GetTime(),
+		encoding.ExtractMisbehaviorsFromRequest(req),
+		encoding.ExtractVotes(req.GetDecidedLastCommit().Votes),
+	)
+	st := s.storageBackend.StateFromContext(ctx)
+	if _, err = s.executeStateTransition(ctx, st, consensusBlk, verifyPayload); err != nil {
+		return fmt.Errorf("failed state transition: %w", err)
+	}
+
+	// STEP 5: Store the block indices and prune the stores as FinalizeBlock.
+	if err = s.storageBackend.BlockStore().Set(blk); err != nil {
+		return fmt.Errorf("failed to store block: %w", err)
+	}
+	if err = s.storageBackend.SignedBlockStore().Set(ctx, signedBlk); err != nil {
+		return fmt.Errorf("failed to store signed block: %w", err)
+	}
+	if err = s.processPruning(ctx, blk); err != nil {
+		s.logger.Error("failed to processPruning", "error", err)
+	}
+	return nil
+}
+
+// storeMissingDeposits stores the given contiguous deposits which are not in
+// the deposit store yet.
+func (s *Service) storeMissingDeposits(ctx context.Context, deposits []*ctypes.Deposit) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+	stored, err := s.storageBackend.DepositStore().GetDepositsByIndex(
+		ctx, deposits[0].GetIndex().Unwrap(), uint64(len(deposits)),
+	)
+	if err != nil {
+		return err
+	}
+	return s.storageBackend.DepositStore().EnqueueDeposits(ctx, deposits[len(stored):])
+}
@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+// noopBlobProcessor accepts any blob sidecars.
+type noopBlobProcessor struct{}
+
+func (noopBlobProcessor) ProcessSidecars(*dastore.Store, datypes.BlobSidecars) error {
+	return nil
+}
+
+func (noopBlobProcessor) VerifySidecars(
+	context.Context,
+	datypes.BlobSidecars,
+	*ctypes.BeaconBlockHeader,
+	eip4844.KZGCommitments[common.ExecutionHash],
+) error {
+	return nil
+}
+
+// TestReplayBlock shows that replaying a finalized block rebuilds the state
+// committed to by the block, storing the block deposits missing from the
+// deposit store, while the deposits already stored are the reference the
+// block deposits are validated against.
+func TestReplayBlock(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	credentials := ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	genDeposits := ctypes.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials,
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       0,
+		},
+	}
+	deposit := &ctypes.Deposit{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+		Index:       1,
+	}
+
+	tests := []struct {
+		name          string
+		storedDeposit *ctypes.Deposit
+		wantErr       error
+	}{
+		{
+			name: "deposit missing from the store",
+		},
+		{
+			name:          "deposit matching the store",
+			storedDeposit: deposit,
+		},
+		{
+			name: "deposit mismatching the store",
+			storedDeposit: &ctypes.Deposit{
+				Pubkey:      deposit.Pubkey,
+				Credentials: credentials,
+				Amount:      math.Gwei(cs.EffectiveBalanceIncrement()),
+				Index:       deposit.Index,
+			},
+			wantErr: core.ErrDepositMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sp, st, ds, txCtx, _, _ := statetransition.SetupTestState(t, cs)
+			ctx := sdk.UnwrapSDKContext(txCtx.ConsensusCtx())
+
+			genPayloadHeader := &ctypes.ExecutionPayloadHeader{
+				Versionable: ctypes.NewVersionable(cs.GenesisForkVersion()),
+			}
+			require.NoError(t, ds.EnqueueDeposits(ctx, genDeposits))
+			_, err := sp.InitializeBeaconStateFromEth1(
+				st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+			)
+			require.NoError(t, err)
+
+			// Build the block as finalized by consensus, committing to the
+			// state it leads to.
+			timestamp := math.U64(10)
+			blk := buildReplayBlock(
+				t, cs, st,
+				ctypes.NewEth1Data(append(genDeposits, deposit).HashTreeRoot()),
+				timestamp, ctypes.Deposits{deposit}, st.EVMInflationWithdrawal(timestamp),
+			)
+			expectedSt := st.Copy(ctx)
+			require.NoError(t, ds.EnqueueDeposits(ctx, ctypes.Deposits{deposit}))
+			_, err = sp.Transition(txCtx, expectedSt, blk)
+			require.NoError(t, err)
+			blk.SetStateRoot(expectedSt.HashTreeRoot())
+
+			// Reset the deposit store to the tested contents.
+			require.NoError(t, ds.Truncate(ctx, deposit.Index))
+			if tt.storedDeposit != nil {
+				require.NoError(t, ds.EnqueueDeposits(ctx, ctypes.Deposits{tt.storedDeposit}))
+			}
+
+			s := newReplayTestService(t, cs, sp, st, ds)
+			err = s.ReplayBlock(ctx, newReplayRequest(t, blk, timestamp), false)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, blk.GetStateRoot(), st.HashTreeRoot())
+			stored, err := ds.GetDepositsByIndex(ctx, deposit.Index, 1)
+			require.NoError(t, err)
+			require.Equal(t, ctypes.Deposits{deposit}, stored)
+			slot, err := s.storageBackend.BlockStore().GetSlotByStateRoot(blk.GetStateRoot())
+			require.NoError(t, err)
+			require.Equal(t, blk.GetSlot(), slot)
+		})
+	}
+}
+
+// newReplayTestService returns a Service replaying blocks on the given state
+// and deposit store.
+func newReplayTestService(
+	t *testing.T,
+	cs chain.Spec,
+	sp *statetransition.TestStateProcessorT,
+	st *statetransition.TestBeaconStateT,
+	ds *depositstore.KVStore,
+) *Service {
+	t.Helper()
+	blockDB := dbm.NewMemDB()
+	signedBlockDB := dbm.NewMemDB()
+	availabilityStore := dastore.New(
+		filedb.NewRangeDB(filedb.NewDB(
+			filedb.WithRootDirectory("/replay"),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0700),
+			filedb.WithLogger(log.NewNopLogger()),
+			filedb.WithAferoFS(afero.NewMemMapFs()),
+		)),
+		log.NewNopLogger(),
+	)
+	storageBackend := storage.NewBackend(
+		cs,
+		availabilityStore,
+		&st.KVStore,
+		ds,
+		block.NewStore[*ctypes.BeaconBlock](
+			storage.NewKVStoreProvider(blockDB), blockDB.Close, 8, noop.NewLogger[any](),
+		),
+		block.NewSignedStore(
+			storage.NewKVStoreProvider(signedBlockDB), signedBlockDB.Close, 8, noop.NewLogger[any](),
+		),
+		nil,
+	)
+	return &Service{
+		storageBackend: storageBackend,
+		blobProcessor:  noopBlobProcessor{},
+		logger:         noop.NewLogger[any](),
+		chainSpec:      cs,
+		stateProcessor: sp,
+		metrics:        newChainMetrics(metrics.NewNoOpTelemetrySink()),
+	}
+}
+
+// newReplayRequest returns the FinalizeBlock request of the given block, as
+// stored by CometBFT.
+func newReplayRequest(
+	t *testing.T, blk *ctypes.BeaconBlock, timestamp math.U64,
+) *cmtabci.FinalizeBlockRequest {
+	t.Helper()
+	blkBz, err := (&ctypes.SignedBeaconBlock{BeaconBlock: blk}).MarshalSSZ()
+	require.NoError(t, err)
+	sidecarsBz, err := (&datypes.BlobSidecars{}).MarshalSSZ()
+	require.NoError(t, err)
+	return &cmtabci.FinalizeBlockRequest{
+		Txs:             [][]byte{blkBz, sidecarsBz},
+		Height:          int64(blk.GetSlot().Unwrap()),           //#nosec: G115
+		Time:            time.Unix(int64(timestamp.Unwrap()), 0), //#nosec: G115
+		ProposerAddress: statetransition.DummyProposerAddr,
+		SyncingToHeight: int64(blk.GetSlot().Unwrap()), //#nosec: G115
+	}
+}
+
+// buildReplayBlock builds the block following the latest block header of the
+// given state, without execution requests.
+func buildReplayBlock(
+	t *testing.T,
+	cs chain.Spec,
+	st *statedb.StateDB,
+	eth1Data *ctypes.Eth1Data,
+	timestamp math.U64,
+	deposits ctypes.Deposits,
+	withdrawals ...*engineprimitives.Withdrawal,
+) *ctypes.BeaconBlock {
+	t.Helper()
+	parentBlkHeader, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	parentBlkHeader.SetStateRoot(st.HashTreeRoot())
+
+	fv := cs.ActiveForkVersionForTimestamp(timestamp)
+	versionable := ctypes.NewVersionable(fv)
+	blk, err := ctypes.NewBeaconBlockWithVersion(
+		parentBlkHeader.GetSlot()+1,
+		parentBlkHeader.GetProposerIndex(),
+		parentBlkHeader.HashTreeRoot(),
+		fv,
+	)
+	require.NoError(t, err)
+
+	payload := &ctypes.ExecutionPayload{
+		Versionable:   versionable,
+		Timestamp:     timestamp,
+		ExtraData:     []byte("testing"),
+		Transactions:  [][]byte{},
+		Withdrawals:   withdrawals,
+		BaseFeePerGas: math.NewU256(0),
+	}
+	parentBeaconBlockRoot := parentBlkHeader.HashTreeRoot()
+	var ethBlk *gethprimitives.Block
+	if version.IsBefore(fv, version.Electra()) {
+		ethBlk, _, err = ctypes.MakeEthBlock(payload, &parentBeaconBlockRoot)
+	} else {
+		encodedER, erErr := ctypes.GetExecutionRequestsList(&ctypes.ExecutionRequests{})
+		require.NoError(t, erErr)
+		ethBlk, _, err = ctypes.MakeEthBlockWithExecutionRequests(
+			payload, &parentBeaconBlockRoot, encodedER,
+		)
+	}
+	require.NoError(t, err)
+	payload.BlockHash = common.ExecutionHash(ethBlk.Hash())
+
+	blk.Body = &ctypes.BeaconBlockBody{
+		Versionable:      versionable,
+		ExecutionPayload: payload,
+		Eth1Data:         eth1Data,
+		Deposits:         deposits,
+	}
+	if version.EqualsOrIsAfter(fv, version.Electra()) {
+		require.NoError(t, blk.Body.SetExecutionRequests(&ctypes.ExecutionRequests{}))
+	}
+	return blk
+}
@@ -55,6 +55,14 @@ type Service struct {
 	executionEngine ExecutionEngine
 	// localBuilder is a local builder for constructing new beacon states.
 	localBuilder LocalBuilder
+	// attributesFactory is used to build the payload attributes published
+	// to the event stream.
+	attributesFactory AttributesFactory
+	// eventPublisher publishes chain events to the node API event stream.
+	eventPublisher EventPublisher
+	// stateHistory gives access to the historical states, used to rebuild
+	// the block store indices.
+	stateHistory StateHistory
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor
 	// metrics is the metrics for the service.
@@ -64,6 +72,10 @@ type Service struct {
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// proposalResult is the result of the last block accepted in
+	// ProcessProposal, reused to finalize the block. It needs no lock as
+	// CometBFT calls ProcessProposal and FinalizeBlock sequentially.
+	proposalResult *proposalResult
 }
 
 // NewService creates a new validator service.
@@ -76,6 +88,8 @@ func NewService(
 	chainSpec ServiceChainSpec,
 	executionEngine ExecutionEngine,
 	localBuilder LocalBuilder,
+	attributesFactory AttributesFactory,
+	eventPublisher EventPublisher,
 	stateProcessor StateProcessor,
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
@@ -90,6 +104,8 @@ func NewService(
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
+		attributesFactory:       attributesFactory,
+		eventPublisher:          eventPublisher,
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
@@ -107,10 +123,13 @@ func (s *Service) Start(ctx context.Context) error {
 	// Catchup deposits for failed blocks. TODO: remove.
 	go s.depositCatchupFetcher(ctx)
 
+	// Index the historical blocks missing from the block store.
+	go s.rebuildBlockStore(ctx)
+
 	return nil
 }
 
-// Stop stops the blockchain service and closes the deposit store.
+// Stop stops the blockchain service and closes the deposit and block stores.
 func (s *Service) Stop() error {
 	s.logger.Info("Stopping blockchain service")
 
@@ -119,9 +138,25 @@ func (s *Service) Stop() error {
 		s.logger.Error("failed to close deposit store", "err", err)
 	}
 
+	err = s.storageBackend.BlockStore().Close()
+	if err != nil {
+		s.logger.Error("failed to close block store", "err", err)
+	}
+
+	err = s.storageBackend.SignedBlockStore().Close()
+	if err != nil {
+		s.logger.Error("failed to close signed block store", "err", err)
+	}
+
 	return nil
 }
 
+// AttachStateHistory sets the provider of the historical states, which is only
+// available once the consensus service is built.
+func (s *Service) AttachStateHistory(history StateHistory) {
+	s.stateHistory = history
+}
+
 // StorageBackend returns the storage backend.
 func (s *Service) StorageBackend() StorageBackend {
 	return s.storageBackend
@@ -1 +0,0 @@
-func (s *Service) executeStateTransition(
\ No newline at end of file
@@ -121,6 +121,8 @@ func (s *Service) BuildBlockAndSidecars(
 		ctx,
 		slotData.GetProposerAddress(),
 		slotData.GetConsensusTime(),
+		slotData.GetMisbehaviors(),
+		slotData.GetLastCommitVotes(),
 		st,
 		blk,
 	); err != nil {
@@ -291,36 +293,10 @@ func (s *Service) buildBlockBody(
 	// Set the KZG commitments on the block body.
 	body.SetBlobKzgCommitments(blobsBundle.GetCommitments())
 
-	// Dequeue deposits from the state.
-	depositIndex, err := st.GetEth1DepositIndex()
-	if err != nil {
-		return fmt.Errorf("failed loading eth1 deposit index: %w", err)
-	}
-
-	// Grab all previous deposits from genesis up to the current index + max deposits per block.
-	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
-		ctx,
-		constants.FirstDepositIndex,
-		depositIndex+s.chainSpec.MaxDepositsPerBlock(),
-	)
-	if err != nil {
+	// Set the legacy deposits from the deposit store on the block body.
+	if err := s.buildDeposits(ctx, st, body); err != nil {
 		return err
 	}
-	if uint64(len(deposits)) < depositIndex {
-		return errors.Wrapf(ErrDepositStoreIncomplete,
-			"all historical deposits not available, expected: %d, got: %d",
-			depositIndex, len(deposits),
-		)
-	}
-
-	eth1Data := ctypes.NewEth1Data(deposits.HashTreeRoot())
-	body.SetEth1Data(eth1Data)
-
-	s.logger.Info(
-		"Building block body with local deposits",
-		"start_index", depositIndex, "num_deposits", uint64(len(deposits))-depositIndex,
-	)
-	body.SetDeposits(deposits[depositIndex:])
 
 	// Set the graffiti on the block body.
 	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
@@ -351,8 +327,109 @@ func (s *Service) buildBlockBody(
 		if err = body.SetExecutionRequests(requests); err != nil {
 			return err
 		}
+
+		// Set the pending voluntary exits on the block body.
+		if err = s.buildVoluntaryExits(st, body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildVoluntaryExits sets the voluntary exits from the pool which apply to
+// the state on the block body, up to the maximum number of exits per block.
+// Exits which do not apply anymore, e.g. because the validator is already
+// exiting, are dropped from the pool.
+func (s *Service) buildVoluntaryExits(
+	st *statedb.StateDB,
+	body *ctypes.BeaconBlockBody,
+) error {
+	// Exits can only be verified once the state has been upgraded to Electra.
+	fork, err := st.GetFork()
+	if err != nil {
+		return fmt.Errorf("failed loading fork: %w", err)
+	}
+	if version.IsBefore(fork.CurrentVersion, version.Electra()) {
+		body.SetVoluntaryExits(ctypes.VoluntaryExits{})
+		return nil
+	}
+
+	pool := s.sb.VoluntaryExitPool()
+	exits := make(ctypes.VoluntaryExits, 0, constants.MaxVoluntaryExits)
+	for _, exit := range pool.Pending() {
+		if len(exits) == constants.MaxVoluntaryExits {
+			break
+		}
+		if err = s.stateProcessor.VerifyVoluntaryExit(st, exit); err != nil {
+			s.logger.Info(
+				"Dropping voluntary exit from pool",
+				"validator_index", exit.Message.ValidatorIndex, "reason", err,
+			)
+			pool.Remove(exit.Message.ValidatorIndex)
+			continue
+		}
+		exits = append(exits, exit)
 	}
+	body.SetVoluntaryExits(exits)
+	return nil
+}
 
+// buildDeposits sets the deposits read from the deposit contract logs on the
+// block body, along with the matching eth1 data. From Electra, these legacy
+// deposits are only included up to the first EIP-6110 deposit request; after
+// that deposits reach the chain through the payload's execution requests.
+func (s *Service) buildDeposits(
+	ctx context.Context,
+	st *statedb.StateDB,
+	body *ctypes.BeaconBlockBody,
+) error {
+	// Dequeue deposits from the state.
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return fmt.Errorf("failed loading eth1 deposit index: %w", err)
+	}
+	depositIndexLimit, err := st.Eth1DepositIndexLimit()
+	if err != nil {
+		return fmt.Errorf("failed loading eth1 deposit index limit: %w", err)
+	}
+
+	// Legacy deposits are drained, the deposit store is not needed anymore.
+	if depositIndex >= depositIndexLimit {
+		eth1Data, errEth1 := st.GetEth1Data()
+		if errEth1 != nil {
+			return fmt.Errorf("failed loading eth1 data: %w", errEth1)
+		}
+		body.SetEth1Data(eth1Data)
+		body.SetDeposits(ctypes.Deposits{})
+		return nil
+	}
+
+	// Grab all previous deposits from genesis up to the current index + max deposits per block.
+	maxDeposits := min(s.chainSpec.MaxDepositsPerBlock(), depositIndexLimit-depositIndex)
+	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
+		ctx,
+		constants.FirstDepositIndex,
+		depositIndex+maxDeposits,
+	)
+	if err != nil {
+		return err
+	}
+	if uint64(len(deposits)) < depositIndex {
+		return errors.Wrapf(ErrDepositStoreIncomplete,
+			"all historical deposits not available, expected: %d, got: %d",
+			depositIndex, len(deposits),
+		)
+	}
+
+	eth1Data := ctypes.NewEth1Data(deposits.HashTreeRoot())
+	body.SetEth1Data(eth1Data)
+
+	s.logger.Info(
+		"Building block body with local deposits",
+		"start_index", depositIndex, "num_deposits", uint64(len(deposits))-depositIndex,
+	)
+	body.SetDeposits(deposits[depositIndex:])
 	return nil
 }
 
@@ -362,6 +439,8 @@ func (s *Service) computeAndSetStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
@@ -369,6 +448,8 @@ func (s *Service) computeAndSetStateRoot(
 		ctx,
 		proposerAddress,
 		consensusTime,
+		misbehaviors,
+		lastCommitVotes,
 		st,
 		blk,
 	)
@@ -389,6 +470,8 @@ func (s *Service) computeStateRoot(
 	ctx context.Context,
 	proposerAddress []byte,
 	consensusTime math.U64,
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
 	st *statedb.StateDB,
 	blk *ctypes.BeaconBlock,
 ) (common.Root, error) {
@@ -402,6 +485,8 @@ func (s *Service) computeStateRoot(
 		consensusTime,
 		proposerAddress,
 	).
+		WithMisbehaviors(misbehaviors).
+		WithLastCommitVotes(lastCommitVotes).
 		WithVerifyPayload(false).
 		WithVerifyRandao(false).
 		WithVerifyResult(false).
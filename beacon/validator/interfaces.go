@@ -34,6 +34,7 @@ import (
 	"github.com/berachain/beacon-kit/state-transition/core"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	depositdb "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/exitpool"
 )
 
 // BlobFactory represents a blob factory interface.
@@ -86,6 +87,11 @@ type StateProcessor interface {
 		st *statedb.StateDB,
 		blk *ctypes.BeaconBlock,
 	) (transition.ValidatorUpdates, error)
+	// VerifyVoluntaryExit verifies that the signed voluntary exit can be
+	// applied to the given state.
+	VerifyVoluntaryExit(
+		st *statedb.StateDB, exit *ctypes.SignedVoluntaryExit,
+	) error
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -94,6 +100,8 @@ type StorageBackend interface {
 	DepositStore() *depositdb.KVStore
 	// StateFromContext retrieves the beacon state from the context.
 	StateFromContext(context.Context) *statedb.StateDB
+	// VoluntaryExitPool retrieves the pool of the signed voluntary exits.
+	VoluntaryExitPool() *exitpool.Pool
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -92,10 +92,9 @@ type SpecData struct {
 	//
 	// GenesisTime is the time at which the genesis block was created.
 	GenesisTime uint64 `mapstructure:"genesis-time"`
-	// Deneb1ForkTime is the time at which the Deneb1 fork is activated.
-	Deneb1ForkTime uint64 `mapstructure:"deneb-one-fork-time"`
-	// ElectraForkTime is the time at which the Electra fork is activated.
-	ElectraForkTime uint64 `mapstructure:"electra-fork-time"`
+	// Forks is the fork registry of the chain, listing every supported fork
+	// in order of activation, starting with the genesis fork.
+	Forks []ForkData `mapstructure:"forks"`
 
 	// State list lengths
 	//
@@ -117,6 +116,15 @@ type SpecData struct {
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient applied to the effective
+	// balance of a slashed validator to compute its initial penalty.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// ProposerRewardForkTime is the time from which block proposers are
+	// rewarded on the consensus layer.
+	ProposerRewardForkTime uint64 `mapstructure:"proposer-reward-fork-time"`
+	// ProposerRewardPerBlock is the reward (in Gwei) credited to the beacon
+	// balance of the proposer of every block from ProposerRewardForkTime.
+	ProposerRewardPerBlock uint64 `mapstructure:"proposer-reward-per-block"`
 
 	// Capella Values
 	//
@@ -145,6 +153,36 @@ type SpecData struct {
 	// KZGCommitmentInclusionProofDepth is the depth of the KZG inclusion proof.
 	KZGCommitmentInclusionProofDepth uint64 `mapstructure:"kzg-commitment-inclusion-proof-depth"`
 
+	// Electra Values
+	//
+	// MinPerEpochConsolidationChurnLimit is the minimum balance (in Gwei) that
+	// can be consolidated per epoch.
+	MinPerEpochConsolidationChurnLimit uint64 `mapstructure:"min-per-epoch-consolidation-churn-limit"`
+	// ConsolidationChurnLimitQuotient is the quotient applied to the total
+	// active balance to compute the consolidation churn limit of an epoch.
+	ConsolidationChurnLimitQuotient uint64 `mapstructure:"consolidation-churn-limit-quotient"`
+	// MinPerEpochExitChurnLimit is the minimum balance (in Gwei) that can exit
+	// per epoch.
+	MinPerEpochExitChurnLimit uint64 `mapstructure:"min-per-epoch-exit-churn-limit"`
+	// ExitChurnLimitQuotient is the quotient applied to the total active
+	// balance to compute the exit churn limit of an epoch.
+	ExitChurnLimitQuotient uint64 `mapstructure:"exit-churn-limit-quotient"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs between the exit
+	// of a validator and the epoch its balance becomes withdrawable.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+
+	// Liveness Values
+	//
+	// LivenessWindowEpochs is the number of epochs in the sliding window over
+	// which the missed block signatures of validators are tracked.
+	LivenessWindowEpochs uint64 `mapstructure:"liveness-window-epochs"`
+	// MaxMissedSignaturesPerWindow is the maximum number of blocks a validator
+	// can miss signing within the liveness window before being ejected.
+	MaxMissedSignaturesPerWindow uint64 `mapstructure:"max-missed-signatures-per-window"`
+	// MaxLivenessEjectionsPerEpoch is the maximum number of offline validators
+	// ejected per epoch, bounding how fast the active set can shrink.
+	MaxLivenessEjectionsPerEpoch uint64 `mapstructure:"max-liveness-ejections-per-epoch"`
+
 	// Berachain Values at genesis
 	//
 	// ValidatorSetCap is the maximum number of validators that can be active
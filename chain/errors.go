@@ -35,4 +35,72 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrZeroConsolidationChurnLimitQuotient is returned when the
+	// consolidation churn limit quotient is zero.
+	ErrZeroConsolidationChurnLimitQuotient = errors.New(
+		"consolidation churn limit quotient must be non-zero",
+	)
+
+	// ErrInsufficientMinPerEpochConsolidationChurnLimit is returned when the
+	// minimum per epoch consolidation churn limit is less than the effective
+	// balance increment.
+	ErrInsufficientMinPerEpochConsolidationChurnLimit = errors.New(
+		"min per epoch consolidation churn limit must be at least the effective balance increment",
+	)
+
+	// ErrZeroExitChurnLimitQuotient is returned when the exit churn limit
+	// quotient is zero.
+	ErrZeroExitChurnLimitQuotient = errors.New(
+		"exit churn limit quotient must be non-zero",
+	)
+
+	// ErrInsufficientMinPerEpochExitChurnLimit is returned when the minimum
+	// per epoch exit churn limit is less than the effective balance
+	// increment.
+	ErrInsufficientMinPerEpochExitChurnLimit = errors.New(
+		"min per epoch exit churn limit must be at least the effective balance increment",
+	)
+
+	// ErrZeroMinSlashingPenaltyQuotient is returned when the minimum
+	// slashing penalty quotient is zero.
+	ErrZeroMinSlashingPenaltyQuotient = errors.New(
+		"min slashing penalty quotient must be non-zero",
+	)
+
+	// ErrZeroLivenessWindowEpochs is returned when the liveness window is
+	// zero epochs long.
+	ErrZeroLivenessWindowEpochs = errors.New(
+		"liveness window epochs must be non-zero",
+	)
+
+	// ErrEmptyForkRegistry is returned when the fork registry does not
+	// list any fork.
+	ErrEmptyForkRegistry = errors.New(
+		"fork registry must list at least the genesis fork",
+	)
+
+	// ErrUnregisteredForkUpgrade is returned when the fork registry lists a
+	// fork version no upgrade hook is registered for.
+	ErrUnregisteredForkUpgrade = errors.New(
+		"fork registry must only list fork versions with an upgrade hook",
+	)
+
+	// ErrUnorderedForkRegistry is returned when the forks of the registry
+	// are not ordered by version and activation time.
+	ErrUnorderedForkRegistry = errors.New(
+		"fork registry must be ordered by version and activation time",
+	)
+
+	// ErrInvalidForkName is returned when a fork of the registry has an
+	// empty name or the name of another fork.
+	ErrInvalidForkName = errors.New(
+		"fork names must be non-empty and unique",
+	)
+
+	// ErrInvalidFirstForkTime is returned when the first fork of the
+	// registry does not activate at timestamp 0.
+	ErrInvalidFirstForkTime = errors.New(
+		"first fork of the registry must activate at timestamp 0",
+	)
 )
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"fmt"
+	stdmath "math"
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ForkData is an entry of the fork registry of the chain. The registry lists
+// the forks in the order in which they activate, starting with the genesis
+// fork. How the state is upgraded into each fork is defined by the upgrade
+// hook registered for the fork version.
+type ForkData struct {
+	// Name is the name of the fork, unique in the registry.
+	Name string `mapstructure:"name"`
+	// Version is the fork version.
+	Version common.Version `mapstructure:"version"`
+	// Timestamp is the time at which the fork is activated.
+	Timestamp uint64 `mapstructure:"timestamp"`
+}
+
+// forkUpgrades holds the fork versions an upgrade hook is registered for.
+// The state processor implements the upgrades into every supported version,
+// further chain-specific versions are registered along with their hook.
+//
+//nolint:gochecknoglobals // registry of upgrade hooks.
+var forkUpgrades sync.Map
+
+//nolint:gochecknoinits // supported versions are always upgradable.
+func init() {
+	for _, v := range version.GetSupportedVersions() {
+		RegisterForkUpgrade(v)
+	}
+}
+
+// RegisterForkUpgrade records that an upgrade hook is registered for the
+// given fork version, so that the fork registry of a chain spec may list it.
+func RegisterForkUpgrade(forkVersion common.Version) {
+	forkUpgrades.Store(forkVersion, struct{}{})
+}
+
+// HasForkUpgrade returns whether an upgrade hook is registered for the given
+// fork version.
+func HasForkUpgrade(forkVersion common.Version) bool {
+	_, ok := forkUpgrades.Load(forkVersion)
+	return ok
+}
+
+// validateForks ensures the fork registry lists at least one fork, with
+// unique names and versions which have a registered upgrade hook, ordered by
+// version and activation time, and with the first fork active from timestamp
+// 0 so that every timestamp maps to a fork.
+func validateForks(forks []ForkData) error {
+	if len(forks) == 0 {
+		return ErrEmptyForkRegistry
+	}
+	names := make(map[string]struct{}, len(forks))
+	for i, fork := range forks {
+		if _, seen := names[fork.Name]; fork.Name == "" || seen {
+			return fmt.Errorf(
+				"%w: fork %s is named %q", ErrInvalidForkName, fork.Version, fork.Name,
+			)
+		}
+		names[fork.Name] = struct{}{}
+
+		if !HasForkUpgrade(fork.Version) {
+			return fmt.Errorf(
+				"%w: fork %s (%s)", ErrUnregisteredForkUpgrade, fork.Name, fork.Version,
+			)
+		}
+		if i == 0 {
+			if fork.Timestamp != 0 {
+				return fmt.Errorf(
+					"%w: fork %s activates at %d", ErrInvalidFirstForkTime, fork.Name, fork.Timestamp,
+				)
+			}
+			continue
+		}
+
+		prev := forks[i-1]
+		if !version.IsAfter(fork.Version, prev.Version) {
+			return fmt.Errorf(
+				"%w: fork %s (%s) must follow fork %s (%s)",
+				ErrUnorderedForkRegistry, fork.Name, fork.Version, prev.Name, prev.Version,
+			)
+		}
+		if fork.Timestamp < prev.Timestamp {
+			return fmt.Errorf(
+				"%w: fork %s activates at %d, before fork %s at %d",
+				ErrUnorderedForkRegistry, fork.Name, fork.Timestamp, prev.Name, prev.Timestamp,
+			)
+		}
+	}
+	return nil
+}
+
+// Forks returns the fork registry, ordered by activation time.
+func (s spec) Forks() []ForkData {
+	return s.Data.Forks
+}
+
+// ForkTime returns the time at which the fork with the given version takes
+// effect. Versions missing from the registry never take effect.
+func (s spec) ForkTime(forkVersion common.Version) uint64 {
+	for _, fork := range s.Data.Forks {
+		if version.Equals(fork.Version, forkVersion) {
+			return fork.Timestamp
+		}
+	}
+	return stdmath.MaxUint64
+}
+
+// ForkByVersion returns the fork of the registry with the given version, if
+// any.
+func (s spec) ForkByVersion(forkVersion common.Version) (ForkData, bool) {
+	for _, fork := range s.Data.Forks {
+		if version.Equals(fork.Version, forkVersion) {
+			return fork, true
+		}
+	}
+	return ForkData{}, false
+}
+
+// ActiveFork returns the fork of the registry active at the given timestamp,
+// which is the last fork activated at or before it.
+func (s spec) ActiveFork(timestamp math.U64) ForkData {
+	var active ForkData
+	for _, fork := range s.Data.Forks {
+		if fork.Timestamp > timestamp.Unwrap() {
+			break
+		}
+		active = fork
+	}
+	return active
+}
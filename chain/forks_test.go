@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestForkRegistryValidation tests that NewSpec validates the fork registry
+// for ordering and registered upgrade hooks.
+func TestForkRegistryValidation(t *testing.T) {
+	t.Parallel()
+	deneb := chain.ForkData{Name: "deneb", Version: version.Deneb(), Timestamp: 0}
+	deneb1 := chain.ForkData{Name: "deneb1", Version: version.Deneb1(), Timestamp: 10}
+	electra := chain.ForkData{Name: "electra", Version: version.Electra(), Timestamp: 20}
+
+	// A chain-specific fork, whose upgrade hook is registered with the chain.
+	customVersion := common.Version{0x06, 0x00, 0x00, 0x00}
+	chain.RegisterForkUpgrade(customVersion)
+	custom := chain.ForkData{Name: "custom", Version: customVersion, Timestamp: 30}
+
+	tests := []struct {
+		name        string
+		forks       []chain.ForkData
+		expectedErr error
+	}{
+		{
+			name:  "valid registry",
+			forks: []chain.ForkData{deneb, deneb1, electra},
+		},
+		{
+			name:  "forks at the same timestamp",
+			forks: []chain.ForkData{deneb, {Name: "deneb1", Version: version.Deneb1()}, electra},
+		},
+		{
+			name:  "subset of the supported forks",
+			forks: []chain.ForkData{deneb, electra},
+		},
+		{
+			name:  "genesis at a later fork",
+			forks: []chain.ForkData{{Name: "electra", Version: version.Electra()}},
+		},
+		{
+			name:  "extension with a registered fork",
+			forks: []chain.ForkData{deneb, deneb1, electra, custom},
+		},
+		{
+			name:        "empty registry",
+			forks:       nil,
+			expectedErr: chain.ErrEmptyForkRegistry,
+		},
+		{
+			name: "extension without a registered upgrade",
+			forks: []chain.ForkData{
+				deneb, deneb1, electra,
+				{Name: "electra1", Version: version.Electra1(), Timestamp: 30},
+			},
+			expectedErr: chain.ErrUnregisteredForkUpgrade,
+		},
+		{
+			name:        "forks out of version order",
+			forks:       []chain.ForkData{deneb, electra, deneb1},
+			expectedErr: chain.ErrUnorderedForkRegistry,
+		},
+		{
+			name: "forks out of time order",
+			forks: []chain.ForkData{
+				deneb, deneb1, {Name: "electra", Version: version.Electra(), Timestamp: 5},
+			},
+			expectedErr: chain.ErrUnorderedForkRegistry,
+		},
+		{
+			name: "duplicate fork name",
+			forks: []chain.ForkData{
+				deneb, {Name: "deneb", Version: version.Deneb1(), Timestamp: 10}, electra,
+			},
+			expectedErr: chain.ErrInvalidForkName,
+		},
+		{
+			name: "empty fork name",
+			forks: []chain.ForkData{
+				deneb, {Version: version.Deneb1(), Timestamp: 10}, electra,
+			},
+			expectedErr: chain.ErrInvalidForkName,
+		},
+		{
+			name: "same version twice",
+			forks: []chain.ForkData{
+				deneb, {Name: "deneb-again", Version: version.Deneb(), Timestamp: 10}, electra,
+			},
+			expectedErr: chain.ErrUnorderedForkRegistry,
+		},
+		{
+			name: "genesis fork after timestamp 0",
+			forks: []chain.ForkData{
+				{Name: "deneb", Version: version.Deneb(), Timestamp: 1}, deneb1, electra,
+			},
+			expectedErr: chain.ErrInvalidFirstForkTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cs, err := chain.NewSpec(&chain.SpecData{
+				MaxWithdrawalsPerPayload:        2,
+				ConsolidationChurnLimitQuotient: 1,
+				ExitChurnLimitQuotient:          1,
+				MinSlashingPenaltyQuotient:      1,
+				LivenessWindowEpochs:            1,
+				Forks:                           tt.forks,
+			})
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.forks, cs.Forks())
+			last := tt.forks[len(tt.forks)-1]
+			require.Equal(t, last.Version, cs.ActiveForkVersionForTimestamp(math.U64(last.Timestamp)))
+			fork, ok := cs.ForkByVersion(last.Version)
+			require.True(t, ok)
+			require.Equal(t, last, fork)
+		})
+	}
+}
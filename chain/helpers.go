@@ -23,19 +23,11 @@ package chain
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // ActiveForkVersionForTimestamp returns the active fork version for a given timestamp.
 func (s spec) ActiveForkVersionForTimestamp(timestamp math.U64) common.Version {
-	time := timestamp.Unwrap()
-	if time >= s.ElectraForkTime() {
-		return version.Electra()
-	}
-	if time >= s.Deneb1ForkTime() {
-		return version.Deneb1()
-	}
-	return version.Deneb()
+	return s.ActiveFork(timestamp).Version
 }
 
 // GenesisForkVersion returns the fork version at genesis.
@@ -38,6 +38,10 @@ type BalancesSpec interface {
 	// EffectiveBalanceIncrement returns the increment of balance used in reward
 	// calculations.
 	EffectiveBalanceIncrement() uint64
+
+	// MinActivationBalance returns the minimum balance required for a
+	// validator to be activated in Gwei.
+	MinActivationBalance() uint64
 }
 
 type HysteresisSpec interface {
@@ -97,11 +101,17 @@ type ForkSpec interface {
 	// GenesisTime returns the time at which the genesis block was created.
 	GenesisTime() uint64
 
-	// Deneb1ForkTime returns the time at which the Deneb1 fork takes effect.
-	Deneb1ForkTime() uint64
+	// Forks returns the fork registry, ordered by activation time and
+	// starting with the genesis fork.
+	Forks() []ForkData
+
+	// ForkTime returns the time at which the fork with the given version
+	// takes effect.
+	ForkTime(forkVersion common.Version) uint64
 
-	// ElectraForkTime returns the time at which the Electra fork takes effect.
-	ElectraForkTime() uint64
+	// ForkByVersion returns the fork of the registry with the given version,
+	// if any.
+	ForkByVersion(forkVersion common.Version) (ForkData, bool)
 }
 
 type BlobSpec interface {
@@ -132,6 +142,9 @@ type ForkVersionSpec interface {
 	// GenesisForkVersion returns the fork version at genesis.
 	GenesisForkVersion() common.Version
 
+	// ActiveFork returns the fork of the registry active at a given timestamp.
+	ActiveFork(timestamp math.U64) ForkData
+
 	// ActiveForkVersionForTimestamp returns the active fork version for a given timestamp.
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 }
@@ -147,6 +160,15 @@ type EVMInflationSpec interface {
 	EVMInflationPerBlock(timestamp math.U64) uint64
 }
 
+// ProposerRewardSpec defines an interface for accessing the consensus layer
+// rewards of block proposers.
+type ProposerRewardSpec interface {
+	// ProposerRewardPerBlock returns the reward (in Gwei) credited to the
+	// beacon balance of the proposer of a block with the given timestamp.
+	// It is zero before the proposer reward fork.
+	ProposerRewardPerBlock(timestamp math.U64) uint64
+}
+
 type WithdrawalsSpec interface {
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 	// payload.
@@ -157,6 +179,48 @@ type WithdrawalsSpec interface {
 	MaxValidatorsPerWithdrawalsSweep() uint64
 }
 
+type ConsolidationSpec interface {
+	// MinPerEpochConsolidationChurnLimit returns the minimum balance that can
+	// be consolidated per epoch.
+	MinPerEpochConsolidationChurnLimit() uint64
+
+	// ConsolidationChurnLimitQuotient returns the quotient applied to the
+	// total active balance to compute the consolidation churn limit.
+	ConsolidationChurnLimitQuotient() uint64
+}
+
+// ExitSpec defines an interface for accessing the exit queue parameters.
+type ExitSpec interface {
+	// MinPerEpochExitChurnLimit returns the minimum balance that can exit per
+	// epoch.
+	MinPerEpochExitChurnLimit() uint64
+
+	// ExitChurnLimitQuotient returns the quotient applied to the total active
+	// balance to compute the exit churn limit.
+	ExitChurnLimitQuotient() uint64
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between
+	// the exit of a validator and the epoch its balance becomes withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
+}
+
+// LivenessSpec defines an interface for accessing the liveness tracking
+// parameters.
+type LivenessSpec interface {
+	// LivenessWindowEpochs returns the number of epochs in the sliding window
+	// over which missed block signatures are tracked.
+	LivenessWindowEpochs() uint64
+
+	// MaxMissedSignaturesPerWindow returns the maximum number of blocks a
+	// validator can miss signing within the liveness window before being
+	// ejected.
+	MaxMissedSignaturesPerWindow() uint64
+
+	// MaxLivenessEjectionsPerEpoch returns the maximum number of offline
+	// validators ejected per epoch.
+	MaxLivenessEjectionsPerEpoch() uint64
+}
+
 // Spec defines an interface for accessing chain-specific parameters.
 type Spec interface {
 	DepositSpec
@@ -167,7 +231,11 @@ type Spec interface {
 	BlobSpec
 	ForkVersionSpec
 	EVMInflationSpec
+	ProposerRewardSpec
 	WithdrawalsSpec
+	ConsolidationSpec
+	ExitSpec
+	LivenessSpec
 
 	// Time parameters constants.
 
@@ -218,6 +286,10 @@ type Spec interface {
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient used to compute the
+	// initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot math.Slot) math.Epoch
 
@@ -249,6 +321,38 @@ func (s spec) validate() error {
 		return ErrInvalidValidatorSetCap
 	}
 
+	if s.Data.ConsolidationChurnLimitQuotient == 0 {
+		return ErrZeroConsolidationChurnLimitQuotient
+	}
+
+	// The consolidation churn is rounded down to a multiple of the effective
+	// balance increment, so its floor must be at least one increment.
+	if s.Data.MinPerEpochConsolidationChurnLimit < s.Data.EffectiveBalanceIncrement {
+		return ErrInsufficientMinPerEpochConsolidationChurnLimit
+	}
+
+	if s.Data.ExitChurnLimitQuotient == 0 {
+		return ErrZeroExitChurnLimitQuotient
+	}
+
+	// The exit churn is rounded down to a multiple of the effective balance
+	// increment, so its floor must be at least one increment.
+	if s.Data.MinPerEpochExitChurnLimit < s.Data.EffectiveBalanceIncrement {
+		return ErrInsufficientMinPerEpochExitChurnLimit
+	}
+
+	if s.Data.MinSlashingPenaltyQuotient == 0 {
+		return ErrZeroMinSlashingPenaltyQuotient
+	}
+
+	if s.Data.LivenessWindowEpochs == 0 {
+		return ErrZeroLivenessWindowEpochs
+	}
+
+	if err := validateForks(s.Data.Forks); err != nil {
+		return err
+	}
+
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
 	// TODO: Add more validation rules here.
@@ -270,6 +374,13 @@ func (s spec) EffectiveBalanceIncrement() uint64 {
 	return s.Data.EffectiveBalanceIncrement
 }
 
+// MinActivationBalance returns the minimum balance required for a validator
+// to be activated, which is one effective balance increment above the
+// ejection balance.
+func (s spec) MinActivationBalance() uint64 {
+	return s.Data.EjectionBalance + s.Data.EffectiveBalanceIncrement
+}
+
 func (s spec) HysteresisQuotient() uint64 {
 	return s.Data.HysteresisQuotient
 }
@@ -370,16 +481,6 @@ func (s spec) GenesisTime() uint64 {
 	return s.Data.GenesisTime
 }
 
-// Deneb1ForkTime returns the epoch of the Deneb1 fork.
-func (s spec) Deneb1ForkTime() uint64 {
-	return s.Data.Deneb1ForkTime
-}
-
-// ElectraForkTime returns the epoch of the Electra fork.
-func (s spec) ElectraForkTime() uint64 {
-	return s.Data.ElectraForkTime
-}
-
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (s spec) EpochsPerHistoricalVector() uint64 {
 	return s.Data.EpochsPerHistoricalVector
@@ -410,6 +511,20 @@ func (s spec) ProportionalSlashingMultiplier() uint64 {
 	return s.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (s spec) MinSlashingPenaltyQuotient() uint64 {
+	return s.Data.MinSlashingPenaltyQuotient
+}
+
+// ProposerRewardPerBlock returns the reward (in Gwei) credited to the proposer
+// of a block with the given timestamp.
+func (s spec) ProposerRewardPerBlock(timestamp math.U64) uint64 {
+	if timestamp.Unwrap() < s.Data.ProposerRewardForkTime {
+		return 0
+	}
+	return s.Data.ProposerRewardPerBlock
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (s spec) MaxWithdrawalsPerPayload() uint64 {
@@ -421,6 +536,53 @@ func (s spec) MaxValidatorsPerWithdrawalsSweep() uint64 {
 	return s.Data.MaxValidatorsPerWithdrawalsSweep
 }
 
+// MinPerEpochConsolidationChurnLimit returns the minimum balance that can be
+// consolidated per epoch.
+func (s spec) MinPerEpochConsolidationChurnLimit() uint64 {
+	return s.Data.MinPerEpochConsolidationChurnLimit
+}
+
+// ConsolidationChurnLimitQuotient returns the quotient applied to the total
+// active balance to compute the consolidation churn limit.
+func (s spec) ConsolidationChurnLimitQuotient() uint64 {
+	return s.Data.ConsolidationChurnLimitQuotient
+}
+
+// MinPerEpochExitChurnLimit returns the minimum balance that can exit per
+// epoch.
+func (s spec) MinPerEpochExitChurnLimit() uint64 {
+	return s.Data.MinPerEpochExitChurnLimit
+}
+
+// ExitChurnLimitQuotient returns the quotient applied to the total active
+// balance to compute the exit churn limit.
+func (s spec) ExitChurnLimitQuotient() uint64 {
+	return s.Data.ExitChurnLimitQuotient
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs between the
+// exit of a validator and the epoch its balance becomes withdrawable.
+func (s spec) MinValidatorWithdrawabilityDelay() uint64 {
+	return s.Data.MinValidatorWithdrawabilityDelay
+}
+
+// LivenessWindowEpochs returns the number of epochs in the liveness window.
+func (s spec) LivenessWindowEpochs() uint64 {
+	return s.Data.LivenessWindowEpochs
+}
+
+// MaxMissedSignaturesPerWindow returns the maximum number of blocks a
+// validator can miss signing within the liveness window.
+func (s spec) MaxMissedSignaturesPerWindow() uint64 {
+	return s.Data.MaxMissedSignaturesPerWindow
+}
+
+// MaxLivenessEjectionsPerEpoch returns the maximum number of offline
+// validators ejected per epoch.
+func (s spec) MaxLivenessEjectionsPerEpoch() uint64 {
+	return s.Data.MaxLivenessEjectionsPerEpoch
+}
+
 // MinEpochsForBlobsSidecarsRequest returns the minimum number of epochs for
 // blobs sidecars request.
 func (s spec) MinEpochsForBlobsSidecarsRequest() math.Epoch {
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChurnLimitValidation tests that NewSpec rejects churn limits which
+// round down to zero.
+func TestChurnLimitValidation(t *testing.T) {
+	t.Parallel()
+	const increment = 10_000 * 1e9
+
+	tests := []struct {
+		name        string
+		mutate      func(*chain.SpecData)
+		expectedErr error
+	}{
+		{
+			name:   "churn limits of one increment",
+			mutate: func(*chain.SpecData) {},
+		},
+		{
+			name: "consolidation churn limit below the increment",
+			mutate: func(data *chain.SpecData) {
+				data.MinPerEpochConsolidationChurnLimit = increment - 1
+			},
+			expectedErr: chain.ErrInsufficientMinPerEpochConsolidationChurnLimit,
+		},
+		{
+			name: "exit churn limit below the increment",
+			mutate: func(data *chain.SpecData) {
+				data.MinPerEpochExitChurnLimit = increment - 1
+			},
+			expectedErr: chain.ErrInsufficientMinPerEpochExitChurnLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			data := &chain.SpecData{
+				EffectiveBalanceIncrement:          increment,
+				MaxWithdrawalsPerPayload:           2,
+				ConsolidationChurnLimitQuotient:    1,
+				MinPerEpochConsolidationChurnLimit: increment,
+				ExitChurnLimitQuotient:             1,
+				MinPerEpochExitChurnLimit:          increment,
+				MinSlashingPenaltyQuotient:         1,
+				LivenessWindowEpochs:               1,
+				Forks: []chain.ForkData{
+					{Name: "electra", Version: version.Electra()},
+				},
+			}
+			tt.mutate(data)
+
+			_, err := chain.NewSpec(data)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
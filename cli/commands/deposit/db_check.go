@@ -52,27 +52,11 @@ func GetDBCheckCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			ctx := sdk.NewContext(
 				app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(logger),
 			).WithContext(cmd.Context())
-			beaconState := app.StorageBackend().StateFromContext(ctx)
-			depositStore := app.StorageBackend().DepositStore()
-
 			// Verify that the deposit store is in sync with the Beacon state.
-			eth1Data, err := beaconState.GetEth1Data()
-			if err != nil {
-				return err
-			}
-			if err = core.ValidateNonGenesisDeposits(
+			if err = core.ValidateDepositStore(
 				ctx,
-				beaconState,
-				depositStore,
-				// maxDepositsPerBlock: 0
-				// In this snapshotted state, we will check up to the existing deposits and not any more.
-				0,
-				// blkDeposits: nil
-				// There are no new block deposits as we are checking at this snapshotted state.
-				nil,
-				// blkDepositRoot: eth1Data.DepositRoot
-				// We will compare against the beacon state's deposit root at this snapshotted state.
-				eth1Data.DepositRoot,
+				app.StorageBackend().StateFromContext(ctx),
+				app.StorageBackend().DepositStore(),
 			); err != nil {
 				return err
 			}
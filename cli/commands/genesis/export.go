@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"errors"
+	"fmt"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// flagExportHeight is the flag for the height of the state to export.
+const flagExportHeight = "height"
+
+// ExportGenesisCmd returns a command that exports the beacon state at a given
+// height to a beacon genesis, to restart the chain from that state.
+//
+//nolint:funlen // flat sequence of steps.
+func ExportGenesisCmd(
+	appCreator servertypes.AppCreator, chainSpecCreator servertypes.ChainSpecCreator,
+) *cobra.Command {
+	var height int64
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "exports the beacon state at a given height to a beacon genesis",
+		Long: `
+Exports the beacon state at --height to the beacon module genesis of a new chain,
+to restart the chain from a halted height. The exported genesis is marked by its
+restart section, which carries the validator registry with the balances, the
+withdrawal indices, the pending partial withdrawals and the slashings of the
+state. Every validator is kept, including the ones which exited, so that the
+validator indices are preserved. Epochs are rebased so that the epoch of the
+exported state is the genesis epoch of the new chain.
+
+The deposits of the exported genesis are all the deposits included in the chain,
+read from the deposit store, along with the eth1 deposit index and deposit root
+of the state. They are not signed, as the validators already proved possession
+of their keys on the exported chain. The execution payload header is the latest
+one of the state, and the genesis time of the chain spec of the new chain must
+match its timestamp.
+
+The genesis is written to --output-document if set, and to stdout otherwise.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := context.GetViperFromCmd(cmd)
+			logger := context.GetLoggerFromCmd(cmd)
+			cfg := context.GetConfigFromCmd(cmd)
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, db, nil, cfg, v)
+
+			cms := app.CommitMultiStore()
+			latestHeight := cms.LatestVersion()
+			if height == 0 {
+				height = latestHeight
+			}
+			if height <= 0 || height > latestHeight {
+				return fmt.Errorf(
+					"cannot export height %d, the latest height is %d", height, latestHeight,
+				)
+			}
+			ms, err := cms.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load state at height %d: %w", height, err)
+			}
+			ctx := sdk.NewContext(
+				ms, false, servercmtlog.WrapSDKLogger(logger),
+			).WithContext(cmd.Context())
+
+			// The deposit store may hold deposits of blocks above the height,
+			// so only the ones included in the state are read.
+			st := app.StorageBackend().StateFromContext(ctx)
+			depositIndex, err := st.GetEth1DepositIndex()
+			if err != nil {
+				return err
+			}
+			deposits, err := app.StorageBackend().DepositStore().GetDepositsByIndex(
+				ctx, constants.FirstDepositIndex, depositIndex,
+			)
+			if err != nil {
+				return err
+			}
+
+			genesis, err := ExportGenesis(chainSpec, st, deposits)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(genesis, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if outputDocument == "" {
+				cmd.Printf("%s\n", bz)
+				return nil
+			}
+			//nolint:mnd // file permissions.
+			return afero.WriteFile(afero.NewOsFs(), outputDocument, append(bz, '\n'), 0o644)
+		},
+	}
+
+	cmd.Flags().Int64Var(
+		&height, flagExportHeight, 0, "height of the state to export (defaults to the latest height)",
+	)
+	cmd.Flags().String(
+		flags.FlagOutputDocument, "", "file to write the genesis to (defaults to stdout)",
+	)
+	return cmd
+}
+
+// ExportGenesis builds a beacon genesis restarting the chain from the given
+// state, whose included deposits are the given ones. Epochs of the state are
+// rebased so that the epoch of the state is the genesis epoch.
+//
+//nolint:funlen // flat sequence of state reads.
+func ExportGenesis(
+	cs ExportChainSpec, st *statedb.StateDB, deposits types.Deposits,
+) (*types.Genesis, error) {
+	fork, err := st.GetFork()
+	if err != nil {
+		return nil, err
+	}
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	epoch := cs.SlotToEpoch(slot)
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	// Deposits.
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, err
+	}
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(deposits)) != depositIndex {
+		return nil, fmt.Errorf(
+			"got %d deposits, the state included %d", len(deposits), depositIndex,
+		)
+	}
+	if !deposits.HashTreeRoot().Equals(eth1Data.DepositRoot) {
+		return nil, errors.New("deposits do not match the deposit root of the state")
+	}
+
+	// Validators.
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+	if len(balances) != len(validators) {
+		return nil, fmt.Errorf(
+			"state holds %d balances for %d validators", len(balances), len(validators),
+		)
+	}
+	restartBalances := make([]math.Gwei, len(balances))
+	for i, val := range validators {
+		val.SetActivationEligibilityEpoch(rebaseEpoch(val.GetActivationEligibilityEpoch(), epoch))
+		val.SetActivationEpoch(rebaseEpoch(val.GetActivationEpoch(), epoch))
+		val.SetExitEpoch(rebaseEpoch(val.GetExitEpoch(), epoch))
+		val.SetWithdrawableEpoch(rebaseEpoch(val.GetWithdrawableEpoch(), epoch))
+		restartBalances[i] = math.Gwei(balances[i])
+	}
+
+	// Withdrawals.
+	nextWithdrawalIndex, err := st.GetNextWithdrawalIndex()
+	if err != nil {
+		return nil, err
+	}
+	nextWithdrawalValidatorIndex, err := st.GetNextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, err
+	}
+	var pendingPartialWithdrawals []*types.PendingPartialWithdrawal
+	if version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		pendingPartialWithdrawals, err = st.GetPendingPartialWithdrawals()
+		if err != nil {
+			return nil, err
+		}
+		for _, withdrawal := range pendingPartialWithdrawals {
+			withdrawal.WithdrawableEpoch = rebaseEpoch(withdrawal.WithdrawableEpoch, epoch)
+		}
+	}
+
+	// Slashings, rotated so that the slot of the state epoch comes first, as
+	// the vector is indexed by epoch. Trailing empty slots are left out.
+	slashingsVectorLen := cs.EpochsPerSlashingsVector()
+	slashings := make([]math.Gwei, slashingsVectorLen)
+	for i := range slashingsVectorLen {
+		slashings[i], err = st.GetSlashingAtIndex((epoch.Unwrap() + i) % slashingsVectorLen)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for len(slashings) > 0 && slashings[len(slashings)-1] == 0 {
+		slashings = slashings[:len(slashings)-1]
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Genesis{
+		ForkVersion:            fork.CurrentVersion,
+		Deposits:               deposits,
+		ExecutionPayloadHeader: header,
+		Restart: &types.GenesisRestart{
+			Eth1DepositIndex:             depositIndex,
+			DepositRoot:                  eth1Data.DepositRoot,
+			NextWithdrawalIndex:          nextWithdrawalIndex,
+			NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
+			Validators:                   validators,
+			Balances:                     restartBalances,
+			PendingPartialWithdrawals:    pendingPartialWithdrawals,
+			Slashings:                    slashings,
+			TotalSlashing:                totalSlashing,
+		},
+	}, nil
+}
+
+// rebaseEpoch returns the given epoch relative to the epoch of the exported
+// state. Epochs up to it are in the past and map to the genesis epoch, while
+// the far future epoch is kept as is.
+func rebaseEpoch(e, stateEpoch math.Epoch) math.Epoch {
+	switch {
+	case e == math.Epoch(constants.FarFutureEpoch):
+		return e
+	case e <= stateEpoch:
+		return constants.GenesisEpoch
+	default:
+		return e - stateEpoch
+	}
+}
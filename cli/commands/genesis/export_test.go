@@ -0,0 +1,246 @@
+//go:build test
+// +build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/genesis"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/json"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:funlen,maintidx // round trip of the full restart state.
+func TestExportGenesis(t *testing.T) {
+	t.Parallel()
+	// Start the chain at Electra, which has pending partial withdrawals.
+	specData := spec.DevnetChainSpecData()
+	specData.Forks = []chain.ForkData{{Name: "electra", Version: version.Electra()}}
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		genDeposits = types.Deposits{
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+				Amount:      maxBalance - 4*increment,
+				Index:       0,
+			},
+			{
+				Pubkey:      [48]byte{0x02},
+				Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x02}),
+				Amount:      maxBalance,
+				Index:       1,
+			},
+			{
+				Pubkey:      [48]byte{0x03},
+				Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x03}),
+				Amount:      maxBalance,
+				Index:       2,
+			},
+		}
+		// A top up of the first validator, included after genesis.
+		topUp = &types.Deposit{
+			Pubkey:      genDeposits[0].Pubkey,
+			Credentials: genDeposits[0].Credentials,
+			Amount:      increment,
+			Index:       3,
+		}
+		deposits = append(genDeposits, topUp)
+	)
+
+	// Build the state of a chain which has made some progress.
+	sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st,
+		genDeposits,
+		types.NewEmptyExecutionPayloadHeaderWithVersion(cs.GenesisForkVersion()),
+		cs.GenesisForkVersion(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	const stateEpoch = 10
+	require.NoError(t, st.SetSlot(math.Slot(stateEpoch*cs.SlotsPerEpoch())))
+
+	// The top up was included.
+	require.NoError(t, st.SetEth1DepositIndex(uint64(len(deposits))))
+	require.NoError(t, st.SetEth1Data(types.NewEth1Data(deposits.HashTreeRoot())))
+	require.NoError(t, st.IncreaseBalance(0, topUp.Amount))
+
+	// Validator 1 is exiting and validator 2 was slashed and exited, but
+	// neither is withdrawn yet.
+	exiting, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	exiting.SetExitEpoch(stateEpoch + 2)
+	exiting.SetWithdrawableEpoch(stateEpoch + 5)
+	require.NoError(t, st.UpdateValidatorAtIndex(1, exiting))
+	slashed, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	slashed.SetSlashed(true)
+	slashed.SetExitEpoch(stateEpoch - 2)
+	slashed.SetWithdrawableEpoch(stateEpoch + 4)
+	require.NoError(t, st.UpdateValidatorAtIndex(2, slashed))
+	require.NoError(t, st.DecreaseBalance(2, increment))
+	slashingIdx := (stateEpoch + 3) % cs.EpochsPerSlashingsVector()
+	require.NoError(t, st.SetSlashingAtIndex(slashingIdx, maxBalance))
+	require.NoError(t, st.SetTotalSlashing(maxBalance))
+
+	// Withdrawals have been processed and a partial withdrawal is pending.
+	require.NoError(t, st.SetNextWithdrawalIndex(7))
+	require.NoError(t, st.SetNextWithdrawalValidatorIndex(2))
+	require.NoError(t, st.SetPendingPartialWithdrawals([]*types.PendingPartialWithdrawal{
+		{ValidatorIndex: 0, Amount: increment, WithdrawableEpoch: stateEpoch + 1},
+	}))
+
+	latestHeader := types.NewEmptyExecutionPayloadHeaderWithVersion(cs.GenesisForkVersion())
+	latestHeader.ParentHash = common.ExecutionHash{0xaa}
+	latestHeader.BlockHash = common.ExecutionHash{0xbb}
+	latestHeader.Number = 100
+	latestHeader.GasLimit = 30_000_000
+	latestHeader.GasUsed = 21_000
+	latestHeader.BaseFeePerGas = math.NewU256(7)
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(latestHeader))
+
+	// Export the state to a genesis.
+	exported, err := genesis.ExportGenesis(cs, st, deposits)
+	require.NoError(t, err)
+	require.Equal(t, cs.GenesisForkVersion(), exported.GetForkVersion())
+	require.Equal(t, latestHeader, exported.GetExecutionPayloadHeader())
+	require.Equal(t, deposits, types.Deposits(exported.GetDeposits()))
+
+	restart := exported.GetRestart()
+	require.NotNil(t, restart)
+	require.Equal(t, uint64(len(deposits)), restart.Eth1DepositIndex)
+	require.Equal(t, deposits.HashTreeRoot(), restart.DepositRoot)
+	require.Equal(t, uint64(7), restart.NextWithdrawalIndex)
+	require.Equal(t, math.ValidatorIndex(2), restart.NextWithdrawalValidatorIndex)
+	require.Equal(t, []math.Gwei{maxBalance - 3*increment, maxBalance, maxBalance - increment}, restart.Balances)
+	require.Equal(t, []*types.PendingPartialWithdrawal{
+		{ValidatorIndex: 0, Amount: increment, WithdrawableEpoch: 1},
+	}, restart.PendingPartialWithdrawals)
+	require.Equal(t, []math.Gwei{0, 0, 0, maxBalance}, restart.Slashings)
+	require.Equal(t, maxBalance, restart.TotalSlashing)
+
+	// Every validator is kept, with its epochs rebased to the state epoch.
+	require.Len(t, restart.Validators, len(genDeposits))
+	for i, val := range restart.Validators {
+		require.Equal(t, genDeposits[i].Pubkey, val.GetPubkey())
+		require.Equal(t, constants.GenesisEpoch, val.GetActivationEpoch())
+	}
+	require.Equal(t, math.Epoch(2), restart.Validators[1].GetExitEpoch())
+	require.Equal(t, math.Epoch(5), restart.Validators[1].GetWithdrawableEpoch())
+	require.True(t, restart.Validators[2].IsSlashed())
+	require.Equal(t, constants.GenesisEpoch, restart.Validators[2].GetExitEpoch())
+	require.Equal(t, math.Epoch(4), restart.Validators[2].GetWithdrawableEpoch())
+
+	// The exported genesis must be accepted by the genesis validation, after
+	// a JSON round trip as it would be read from the genesis file, and only
+	// thanks to its restart state.
+	bz, err := json.Marshal(exported)
+	require.NoError(t, err)
+	require.NoError(t, (&cometbft.Service{}).ValidateGenesis(
+		map[string]json.RawMessage{"beacon": bz},
+	))
+	restored := &types.Genesis{}
+	require.NoError(t, json.Unmarshal(bz, restored))
+	require.Equal(t, restart, restored.GetRestart())
+
+	unmarked := *exported
+	unmarked.Restart = nil
+	unmarkedBz, err := json.Marshal(&unmarked)
+	require.NoError(t, err)
+	require.ErrorContains(t, (&cometbft.Service{}).ValidateGenesis(
+		map[string]json.RawMessage{"beacon": unmarkedBz},
+	), "duplicate pubkey")
+	unmarked.Deposits = genDeposits
+	unmarkedBz, err = json.Marshal(&unmarked)
+	require.NoError(t, err)
+	require.ErrorContains(t, (&cometbft.Service{}).ValidateGenesis(
+		map[string]json.RawMessage{"beacon": unmarkedBz},
+	), "block number must be zero for genesis block")
+
+	// Restarting from the exported genesis restores the exported state.
+	sp, st, _, _, _, _ = statetransition.SetupTestState(t, cs)
+	genVals, err := sp.InitializeBeaconStateFromEth1(
+		st,
+		restored.GetDeposits(),
+		restored.GetExecutionPayloadHeader(),
+		restored.GetForkVersion(),
+		restored.GetRestart(),
+	)
+	require.NoError(t, err)
+	// The slashed validator exited at genesis, the exiting one is still active.
+	require.Len(t, genVals, 2)
+
+	validators, err := st.GetValidators()
+	require.NoError(t, err)
+	require.Equal(t, types.Validators(restart.Validators), validators)
+	for i, balance := range restart.Balances {
+		var got math.Gwei
+		got, err = st.GetBalance(math.ValidatorIndex(i))
+		require.NoError(t, err)
+		require.Equal(t, balance, got)
+	}
+
+	depositIndex, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(deposits)), depositIndex)
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	require.Equal(t, deposits.HashTreeRoot(), eth1Data.DepositRoot)
+
+	nextWithdrawalIndex, err := st.GetNextWithdrawalIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), nextWithdrawalIndex)
+	nextWithdrawalValidatorIndex, err := st.GetNextWithdrawalValidatorIndex()
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(2), nextWithdrawalValidatorIndex)
+	pendingPartialWithdrawals, err := st.GetPendingPartialWithdrawals()
+	require.NoError(t, err)
+	require.Equal(t, restart.PendingPartialWithdrawals, pendingPartialWithdrawals)
+
+	slashing, err := st.GetSlashingAtIndex(3)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, slashing)
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, totalSlashing)
+
+	header, err := st.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	require.Equal(t, latestHeader, header)
+}
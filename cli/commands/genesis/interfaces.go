@@ -23,6 +23,7 @@ package genesis
 import (
 	"github.com/berachain/beacon-kit/cli/utils/genesis"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 type ChainSpec interface {
@@ -32,3 +33,9 @@ type ChainSpec interface {
 	MaxWithdrawalsPerPayload() uint64
 	DepositContractAddress() common.ExecutionAddress
 }
+
+// ExportChainSpec defines the chain spec values needed to export a genesis.
+type ExportChainSpec interface {
+	SlotToEpoch(slot math.Slot) math.Epoch
+	EpochsPerSlashingsVector() uint64
+}
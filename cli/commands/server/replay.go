@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	types "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/storage/db"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagReplayFrom          = "from"
+	flagReplayTo            = "to"
+	flagReplayVerifyAppHash = "verify-app-hash"
+	flagReplayVerifyPayload = "verify-payload"
+
+	// replayProgressInterval is the number of blocks replayed between two
+	// progress reports.
+	replayProgressInterval = 100
+)
+
+// NewReplayCmd creates a command to re-execute the blocks of the local
+// CometBFT block store on top of the application state.
+//
+//nolint:funlen // flat sequence of steps.
+func NewReplayCmd(
+	appCreator types.AppCreator,
+) *cobra.Command {
+	var (
+		from, to                     int64
+		verifyAppHash, verifyPayload bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "replay blocks from the local CometBFT block store to rebuild the application state",
+		Long: `
+A replay re-executes the blocks stored by CometBFT from height --from to height
+--to, to recover from a corrupted application state or to reindex the state
+after a state transition fix. The application state is rolled back to height
+--from - 1 before replaying, and the deposit store, the blob store and the block
+indices are rebuilt along with it.
+
+By default payloads are not sent to the execution client, as consensus already
+finalized them. With --verify-payload, every payload is verified by the
+execution client, which must be running.
+
+With --verify-app-hash, the app hash of every replayed height is compared to the
+one committed by CometBFT, and the replay stops at the first mismatch.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			v := clicontext.GetViperFromCmd(cmd)
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+			app := appCreator(logger, db, nil, cfg, v)
+
+			blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = blockStore.Close()
+				_ = stateStore.Close()
+			}()
+			cmtState, err := stateStore.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load CometBFT state: %w", err)
+			}
+
+			// Resolve and check the range of heights to replay.
+			cms := app.CommitMultiStore()
+			latestHeight := cms.LatestVersion()
+			if from == 0 {
+				from = latestHeight + 1
+			}
+			if to == 0 {
+				to = cmtState.LastBlockHeight
+			}
+			switch {
+			case from <= cmtState.InitialHeight:
+				return fmt.Errorf(
+					"cannot replay from height %d, the initial height %d requires the genesis",
+					from, cmtState.InitialHeight,
+				)
+			case from-1 > latestHeight:
+				return fmt.Errorf(
+					"cannot replay from height %d, the latest application height is %d",
+					from, latestHeight,
+				)
+			case to > cmtState.LastBlockHeight:
+				return fmt.Errorf(
+					"cannot replay to height %d, the latest CometBFT height is %d",
+					to, cmtState.LastBlockHeight,
+				)
+			case from > to:
+				return fmt.Errorf("nothing to replay from height %d to height %d", from, to)
+			case from < blockStore.Base():
+				return fmt.Errorf(
+					"cannot replay from height %d, the block store starts at height %d",
+					from, blockStore.Base(),
+				)
+			}
+
+			if from-1 < latestHeight {
+				logger.Info("Rolling back application state", "from", latestHeight, "to", from-1)
+				if err = cms.RollbackToVersion(from - 1); err != nil {
+					return fmt.Errorf("failed to rollback to version: %w", err)
+				}
+			}
+
+			if verifyPayload {
+				if err = app.StartExecutionClient(cmd.Context()); err != nil {
+					return fmt.Errorf("failed to start execution client: %w", err)
+				}
+			}
+
+			replayer := app.BlockReplayer()
+			start := time.Now()
+			for height := from; height <= to; height++ {
+				var req *cmtabci.FinalizeBlockRequest
+				req, err = finalizeBlockRequest(blockStore, stateStore, cmtState.InitialHeight, height, to)
+				if err != nil {
+					return err
+				}
+
+				ms := cms.CacheMultiStore()
+				ctx := sdk.NewContext(
+					ms, false, servercmtlog.WrapSDKLogger(logger),
+				).WithContext(cmd.Context())
+				if err = replayer.ReplayBlock(ctx, req, verifyPayload); err != nil {
+					return fmt.Errorf("failed to replay block %d: %w", height, err)
+				}
+				ms.Write()
+				commitID := cms.Commit()
+
+				if verifyAppHash {
+					if err = checkAppHash(blockStore, cmtState, commitID); err != nil {
+						return err
+					}
+				}
+
+				if height == to || (height-from+1)%replayProgressInterval == 0 {
+					logger.Info(
+						"Replayed blocks",
+						"height", height,
+						"remaining", to-height,
+						"elapsed", time.Since(start).Round(time.Second).String(),
+						"app_hash", fmt.Sprintf("%X", commitID.Hash),
+					)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(
+		&from, flagReplayFrom, 0,
+		"first height to replay (defaults to the height following the application state)",
+	)
+	cmd.Flags().Int64Var(
+		&to, flagReplayTo, 0,
+		"last height to replay (defaults to the latest CometBFT height)",
+	)
+	cmd.Flags().BoolVar(
+		&verifyAppHash, flagReplayVerifyAppHash, false,
+		"compare the app hash of every replayed height to the one committed by CometBFT",
+	)
+	cmd.Flags().BoolVar(
+		&verifyPayload, flagReplayVerifyPayload, false,
+		"verify every payload with the execution client",
+	)
+	return cmd
+}
+
+// loadBlockAndStateStores opens the CometBFT block and state stores.
+func loadBlockAndStateStores(cfg *cmtcfg.Config) (*store.BlockStore, sm.Store, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open CometBFT block store: %w", err)
+	}
+	blockStore := store.NewBlockStore(
+		blockStoreDB, store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	)
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, nil, errors.Join(
+			fmt.Errorf("failed to open CometBFT state store: %w", err),
+			blockStore.Close(),
+		)
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return blockStore, stateStore, nil
+}
+
+// finalizeBlockRequest rebuilds the FinalizeBlock request CometBFT sent for
+// the block stored at the given height.
+func finalizeBlockRequest(
+	blockStore *store.BlockStore,
+	stateStore sm.Store,
+	initialHeight, height, syncingToHeight int64,
+) (*cmtabci.FinalizeBlockRequest, error) {
+	block, _ := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found in the block store", height)
+	}
+
+	var commitInfo cmtabci.CommitInfo
+	if height > initialHeight {
+		lastValSet, err := stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load validators at height %d: %w", height-1, err)
+		}
+		commitInfo = sm.BuildLastCommitInfo(block, lastValSet, initialHeight)
+	}
+
+	return &cmtabci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  commitInfo,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		SyncingToHeight:    syncingToHeight,
+	}, nil
+}
+
+// checkAppHash compares the app hash of the replayed height to the one
+// committed by CometBFT, which is carried by the header of the next block or,
+// for the latest height, by the CometBFT state.
+func checkAppHash(
+	blockStore *store.BlockStore,
+	cmtState sm.State,
+	commitID storetypes.CommitID,
+) error {
+	expected := cmtState.AppHash
+	if commitID.Version != cmtState.LastBlockHeight {
+		nextMeta := blockStore.LoadBlockMeta(commitID.Version + 1)
+		if nextMeta == nil {
+			return fmt.Errorf("block %d not found in the block store", commitID.Version+1)
+		}
+		expected = nextMeta.Header.AppHash
+	}
+
+	if !bytes.Equal(expected, commitID.Hash) {
+		return fmt.Errorf(
+			"app hash mismatch at height %d, expected %X, got %X",
+			commitID.Version, expected, commitID.Hash,
+		)
+	}
+	return nil
+}
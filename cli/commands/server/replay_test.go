@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizeBlockRequest(t *testing.T) {
+	t.Parallel()
+	const latestHeight = 5
+	cfg := cmtcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+	setupChain(t, cfg, latestHeight, false)
+
+	blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, blockStore.Close())
+		require.NoError(t, stateStore.Close())
+	}()
+
+	// The block at the initial height has no last commit.
+	req, err := finalizeBlockRequest(blockStore, stateStore, 1, 1, latestHeight)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), req.Height)
+	require.Empty(t, req.DecidedLastCommit.Votes)
+
+	// Later blocks carry the votes of the validators of the previous height.
+	req, err = finalizeBlockRequest(blockStore, stateStore, 1, 3, latestHeight)
+	require.NoError(t, err)
+	block, _ := blockStore.LoadBlock(3)
+	require.Equal(t, int64(3), req.Height)
+	require.Equal(t, []byte(block.Hash()), req.Hash)
+	require.Equal(t, []byte(block.ProposerAddress), req.ProposerAddress)
+	require.Equal(t, int64(latestHeight), req.SyncingToHeight)
+	require.Len(t, req.DecidedLastCommit.Votes, 1)
+	require.Equal(t, cmtproto.BlockIDFlagCommit, req.DecidedLastCommit.Votes[0].BlockIdFlag)
+
+	_, err = finalizeBlockRequest(blockStore, stateStore, 1, latestHeight+1, latestHeight)
+	require.ErrorContains(t, err, "block 6 not found in the block store")
+}
+
+func TestCheckAppHash(t *testing.T) {
+	t.Parallel()
+	const latestHeight = 5
+	cfg := cmtcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+	setupChain(t, cfg, latestHeight, false)
+
+	blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, blockStore.Close())
+		require.NoError(t, stateStore.Close())
+	}()
+	cmtState, err := stateStore.Load()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		commitID storetypes.CommitID
+		wantErr  string
+	}{
+		{
+			name:     "height below the latest checked against the next block",
+			commitID: storetypes.CommitID{Version: 3, Hash: appHashAt(3)},
+		},
+		{
+			name:     "latest height checked against the CometBFT state",
+			commitID: storetypes.CommitID{Version: latestHeight, Hash: appHashAt(latestHeight)},
+		},
+		{
+			name:     "mismatch",
+			commitID: storetypes.CommitID{Version: 3, Hash: appHashAt(4)},
+			wantErr:  "app hash mismatch at height 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAppHash(blockStore, cmtState, tt.commitID)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -21,26 +21,37 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	"github.com/berachain/beacon-kit/storage/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
 	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
 // NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+// one or more heights.
+//
+//nolint:funlen // flat sequence of steps.
 func NewRollbackCmd(
 	appCreator types.AppCreator,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock bool
+		toHeight    int64
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK and CometBFT state by one or more heights",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
@@ -48,6 +59,14 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also rolls back to height n - 1. No blocks are removed, so upon
 restarting CometBFT the transactions in block n will be re-executed against the
 application.
+
+With --to-height, the state is rolled back to the given height in one operation.
+The blocks above the target height are removed from the block store, except for
+the block right above it which is kept unless --hard is set.
+
+The deposits beyond the deposit count of the rolled back state and the blob
+sidecars above the target height are removed, and the deposit store is then
+checked to be in sync with the rolled back state.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
@@ -61,7 +80,15 @@ application.
 			app := appCreator(logger, db, nil, cfg, v)
 
 			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
+			var (
+				height int64
+				hash   []byte
+			)
+			if toHeight == 0 {
+				height, hash, err = cmtcmd.RollbackState(cfg, removeBlock)
+			} else {
+				height, hash, err = rollbackStateToHeight(cfg, toHeight, removeBlock)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
 			}
@@ -71,16 +98,101 @@ application.
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
+			// rollback the stores kept outside of the multistore
+			ctx := sdk.NewContext(
+				app.CommitMultiStore().CacheMultiStore(), false, servercmtlog.WrapSDKLogger(logger),
+			).WithContext(cmd.Context())
+			storageBackend := app.StorageBackend()
+			depositIndex, err := storageBackend.StateFromContext(ctx).GetEth1DepositIndex()
+			if err != nil {
+				return err
+			}
+			if err = storageBackend.DepositStore().Truncate(ctx, depositIndex); err != nil {
+				return fmt.Errorf("failed to rollback deposit store: %w", err)
+			}
+			//#nosec: G115 // height is positive once rolled back.
+			if err = storageBackend.AvailabilityStore().Truncate(uint64(height) + 1); err != nil {
+				return fmt.Errorf("failed to rollback availability store: %w", err)
+			}
+
 			logger.Info(
 				"Rolled back state",
 				"height", height,
 				"hash", fmt.Sprintf("%X", hash),
+				"deposit_count", depositIndex,
 			)
+
+			if err = core.ValidateDepositStore(
+				ctx, storageBackend.StateFromContext(ctx), storageBackend.DepositStore(),
+			); err != nil {
+				return fmt.Errorf("deposit store out of sync after rollback: %w", err)
+			}
+			logger.Info("✅ Deposit store is in sync with the Beacon state!")
 			return nil
 		},
 	}
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().
+		Int64Var(&toHeight, "to-height", 0, "height to rollback to (defaults to one height below the latest)")
 	return cmd
 }
+
+// rollbackStateToHeight rolls back the CometBFT state height by height until
+// it reaches the target height. The blocks above the target height + 1 are
+// removed along the way, as CometBFT cannot restart with more than one block
+// ahead of its state. Returns the rolled back height and app hash.
+func rollbackStateToHeight(
+	cfg *cmtcfg.Config,
+	toHeight int64,
+	removeBlock bool,
+) (int64, []byte, error) {
+	blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	cmtState, err := stateStore.Load()
+	if err != nil {
+		return -1, nil, err
+	}
+	switch {
+	case cmtState.IsEmpty():
+		return -1, nil, errors.New("no state found")
+	case toHeight >= cmtState.LastBlockHeight:
+		return -1, nil, fmt.Errorf(
+			"cannot rollback to height %d, the latest height is %d",
+			toHeight, cmtState.LastBlockHeight,
+		)
+	case toHeight < max(cmtState.InitialHeight, blockStore.Base()):
+		return -1, nil, fmt.Errorf(
+			"cannot rollback to height %d, the lowest available height is %d",
+			toHeight, max(cmtState.InitialHeight, blockStore.Base()),
+		)
+	}
+
+	// Discard the block that may have been persisted without its state, so
+	// that every step below rolls back the state by one height.
+	if blockStore.Height() == cmtState.LastBlockHeight+1 {
+		if err = blockStore.DeleteLatestBlock(); err != nil {
+			return -1, nil, fmt.Errorf("failed to remove pending block: %w", err)
+		}
+	}
+
+	height, hash := cmtState.LastBlockHeight, cmtState.AppHash
+	for height > toHeight {
+		// Keep the block right above the target height only, unless asked to
+		// remove it too.
+		removeBlockAtHeight := removeBlock || height-1 > toHeight
+		height, hash, err = sm.Rollback(blockStore, stateStore, removeBlockAtHeight)
+		if err != nil {
+			return -1, nil, err
+		}
+	}
+	return height, hash, nil
+}
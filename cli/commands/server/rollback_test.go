@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+	"github.com/stretchr/testify/require"
+)
+
+// appHashAt returns the app hash of the state committed at the given height.
+func appHashAt(height int64) []byte {
+	return tmhash.Sum([]byte(fmt.Sprintf("app_hash_%d", height)))
+}
+
+// setupChain persists blocks and states from height 1 to the latest height,
+// plus a block at the next height without its state if pending is set.
+func setupChain(t *testing.T, cfg *cmtcfg.Config, latestHeight int64, pending bool) {
+	t.Helper()
+	blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, blockStore.Close())
+		require.NoError(t, stateStore.Close())
+	}()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	state, err := sm.MakeGenesisState(&cmttypes.GenesisDoc{
+		ChainID:         "test-chain",
+		GenesisTime:     cmttime.Now(),
+		InitialHeight:   1,
+		ConsensusParams: cmttypes.DefaultConsensusParams(),
+		Validators:      []cmttypes.GenesisValidator{{PubKey: pubKey, Power: 10}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Bootstrap(state))
+
+	lastCommit := &cmttypes.Commit{}
+	saveBlock := func(height int64) cmttypes.BlockID {
+		block := state.MakeBlock(height, nil, lastCommit, nil, pubKey.Address())
+		parts, pErr := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, pErr)
+		blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = &cmttypes.Commit{
+			Height:  height,
+			BlockID: blockID,
+			Signatures: []cmttypes.CommitSig{{
+				BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+				ValidatorAddress: pubKey.Address(),
+				Signature:        []byte{0x01},
+			}},
+		}
+		blockStore.SaveBlock(block, parts, lastCommit)
+		return blockID
+	}
+
+	for height := int64(1); height <= latestHeight; height++ {
+		state.LastBlockID = saveBlock(height)
+		state.LastBlockHeight = height
+		state.LastBlockTime = cmttime.Now()
+		state.LastValidators = state.Validators.Copy()
+		state.Validators = state.NextValidators.Copy()
+		state.NextValidators = state.NextValidators.CopyIncrementProposerPriority(1)
+		state.AppHash = appHashAt(height)
+		require.NoError(t, stateStore.Save(state))
+	}
+	if pending {
+		saveBlock(latestHeight + 1)
+	}
+}
+
+func TestRollbackStateToHeight(t *testing.T) {
+	t.Parallel()
+	const latestHeight = 10
+
+	tests := []struct {
+		name            string
+		pending         bool
+		toHeight        int64
+		removeBlock     bool
+		wantBlockHeight int64
+		wantErr         string
+	}{
+		{
+			name:            "several heights keep the block above the target",
+			toHeight:        5,
+			wantBlockHeight: 6,
+		},
+		{
+			name:            "several heights with the block above the target removed",
+			toHeight:        5,
+			removeBlock:     true,
+			wantBlockHeight: 5,
+		},
+		{
+			name:            "single height keeps the latest block",
+			toHeight:        latestHeight - 1,
+			wantBlockHeight: latestHeight,
+		},
+		{
+			name:            "single height with the latest block removed",
+			toHeight:        latestHeight - 1,
+			removeBlock:     true,
+			wantBlockHeight: latestHeight - 1,
+		},
+		{
+			name:            "pending block deleted before rolling back",
+			pending:         true,
+			toHeight:        5,
+			wantBlockHeight: 6,
+		},
+		{
+			name:            "pending block deleted before rolling back a single height",
+			pending:         true,
+			toHeight:        latestHeight - 1,
+			wantBlockHeight: latestHeight,
+		},
+		{
+			name:            "pending block deleted with the block above the target removed",
+			pending:         true,
+			toHeight:        5,
+			removeBlock:     true,
+			wantBlockHeight: 5,
+		},
+		{
+			name:     "target at the latest height",
+			toHeight: latestHeight,
+			wantErr:  "the latest height is 10",
+		},
+		{
+			name:     "target below the lowest height",
+			toHeight: 0,
+			wantErr:  "the lowest available height is 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := cmtcfg.DefaultConfig()
+			cfg.SetRoot(t.TempDir())
+			setupChain(t, cfg, latestHeight, tt.pending)
+
+			height, hash, err := rollbackStateToHeight(cfg, tt.toHeight, tt.removeBlock)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.toHeight, height)
+			require.Equal(t, appHashAt(tt.toHeight), hash)
+
+			blockStore, stateStore, err := loadBlockAndStateStores(cfg)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, blockStore.Close())
+				require.NoError(t, stateStore.Close())
+			}()
+			state, err := stateStore.Load()
+			require.NoError(t, err)
+			require.Equal(t, tt.toHeight, state.LastBlockHeight)
+			require.Equal(t, appHashAt(tt.toHeight), state.AppHash)
+			require.Equal(t, tt.wantBlockHeight, blockStore.Height())
+			require.Nil(t, blockStore.LoadBlockMeta(tt.wantBlockHeight+1))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/cli/commands/server/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+)
+
+// GetSnapshotOptionsFromFlags parses command flags and returns the state-sync
+// SnapshotOptions. Snapshots cannot be taken if the pruning strategy keeps no
+// historic state.
+func GetSnapshotOptionsFromFlags(
+	appOpts types.AppOptions,
+) (snapshottypes.SnapshotOptions, error) {
+	opts := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
+	)
+
+	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	if strategy == pruningtypes.PruningOptionEverything && opts.Interval > 0 {
+		return opts, fmt.Errorf(
+			"cannot enable state sync snapshots with '%s' pruning setting",
+			pruningtypes.PruningOptionEverything,
+		)
+	}
+
+	return opts, nil
+}
+
+// GetSnapshotStore opens the state-sync snapshot store under
+// <homeDir>/data/snapshots.
+func GetSnapshotStore(homeDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	//#nosec:G301 // snapshots are served to peers, not secret.
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
@@ -45,6 +45,9 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 )
 
 // StartCmdOptions defines options that can be customized in
@@ -88,6 +91,9 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 			if err != nil {
 				return err
 			}
+			if _, err = GetSnapshotOptionsFromFlags(v); err != nil {
+				return err
+			}
 
 			// Open the Database
 			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
@@ -151,6 +157,16 @@ func addStartNodeFlags(
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		Uint64(
+			FlagStateSyncSnapshotInterval,
+			0,
+			"State sync snapshot interval (0 disables snapshots)")
+	cmd.Flags().
+		Uint32(
+			FlagStateSyncSnapshotKeepRecent,
+			2, //nolint:mnd // default number of snapshots to keep.
+			"Number of recent state sync snapshots to keep")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
@@ -123,12 +123,15 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 		"eth1-follow-distance",
 		"target-seconds-per-eth1-block",
 		"genesis-time",
-		"deneb-one-fork-time",
-		"electra-fork-time",
+		"forks",
 		"epochs-per-historical-vector",
 		"epochs-per-slashings-vector",
 		"historical-roots-limit",
 		"validator-registry-limit",
+		"proportional-slashing-multiplier",
+		"min-slashing-penalty-quotient",
+		"proposer-reward-fork-time",
+		"proposer-reward-per-block",
 		"max-withdrawals-per-payload",
 		"max-validators-per-withdrawals-sweep",
 		"min-epochs-for-blobs-sidecars-request",
@@ -137,6 +140,14 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 		"field-elements-per-blob",
 		"bytes-per-blob",
 		"kzg-commitment-inclusion-proof-depth",
+		"min-per-epoch-consolidation-churn-limit",
+		"consolidation-churn-limit-quotient",
+		"min-per-epoch-exit-churn-limit",
+		"exit-churn-limit-quotient",
+		"min-validator-withdrawability-delay",
+		"liveness-window-epochs",
+		"max-missed-signatures-per-window",
+		"max-liveness-ejections-per-epoch",
 		"validator-set-cap",
 		"evm-inflation-address",
 		"evm-inflation-per-block",
@@ -162,10 +173,11 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 	return &specData, nil
 }
 
-// simpleDecodeHook is a decode hook that does two things:
+// simpleDecodeHook is a decode hook that does three things:
 //  1. Converts a string into a common.ExecutionAddress (when target type is ExecutionAddress).
-//  2. Converts numeric values into a [4]byte value using bytes.FromUint32.
-//     Only numeric values are allowed for the domain types.
+//  2. Converts a hex string into a [4]byte value, as used for the fork versions.
+//  3. Converts numeric values into a [4]byte value using bytes.FromUint32,
+//     as used for the domain types.
 func simpleDecodeHook(
 	f reflect.Type,
 	t reflect.Type,
@@ -182,6 +194,19 @@ func simpleDecodeHook(
 		return addr, nil
 	}
 
+	// Convert hex strings to a 4-byte fork version (common.Version is an alias for [4]byte).
+	if f.Kind() == reflect.String && t == reflect.TypeOf(bytes.B4{}) {
+		s, ok := data.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string for [4]byte but got %T", data)
+		}
+		var b bytes.B4
+		if err := b.UnmarshalText([]byte(s)); err != nil {
+			return nil, fmt.Errorf("invalid hex string %q for [4]byte: %w", s, err)
+		}
+		return b, nil
+	}
+
 	// Convert numeric values to a 4-byte domain type (common.DomainType is an alias for [4]byte).
 	if t == reflect.TypeOf(bytes.B4{}) {
 		var num uint64
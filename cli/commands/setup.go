@@ -47,13 +47,15 @@ func DefaultRootCommandSetup(
 		// `init`
 		initialize.InitCmd(chainSpecCreator, mm),
 		// `genesis`
-		genesis.Commands(chainSpecCreator),
+		genesis.Commands(chainSpecCreator, genesis.ExportGenesisCmd(appCreator, chainSpecCreator)),
 		// `deposit`
 		deposit.Commands(chainSpecCreator, appCreator),
 		// `jwt`
 		jwt.Commands(),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
+		// `replay`
+		server.NewReplayCmd(appCreator),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions{
 			AddFlags: flags.AddBeaconKitFlags,
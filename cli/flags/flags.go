@@ -63,10 +63,10 @@ const (
 	Style      = loggerRoot + "style"
 
 	// Block Store Service Config.
-	blockStoreServiceRoot               = beaconKitRoot + "block-store-service."
-	BlockStoreServiceEnabled            = blockStoreServiceRoot + "enabled"
-	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
-		"availability-window"
+	blockStoreServiceRoot           = beaconKitRoot + "block-store-service."
+	BlockStoreServiceEnabled        = blockStoreServiceRoot + "enabled"
+	BlockStoreServiceBlockRetention = blockStoreServiceRoot +
+		"block-retention"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
@@ -159,10 +159,10 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.Enabled,
 		"block service enabled",
 	)
-	startCmd.Flags().Int(
-		BlockStoreServiceAvailabilityWindow,
-		defaultCfg.BlockStoreService.AvailabilityWindow,
-		"block service availability window",
+	startCmd.Flags().Uint64(
+		BlockStoreServiceBlockRetention,
+		defaultCfg.BlockStoreService.BlockRetention,
+		"number of recent slots to keep full blocks for, 0 keeps all",
 	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
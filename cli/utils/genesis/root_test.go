@@ -115,6 +115,7 @@ func TestCompareGenesisCmdWithStateProcessor(t *testing.T) {
 					deposits,
 					genPayloadHeader,
 					cs.GenesisForkVersion(),
+					nil,
 				)
 				require.NoError(t, err)
 
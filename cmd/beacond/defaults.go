@@ -30,6 +30,7 @@ func DefaultComponents() []any {
 		components.ProvideAvailabilityStore,
 		components.ProvideDepositContract,
 		components.ProvideBlockStore,
+		components.ProvideSignedBlockStore,
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor,
 		components.ProvideBlobProofVerifier,
@@ -39,6 +40,7 @@ func DefaultComponents() []any {
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
 		components.ProvideEngineClient,
+		components.ProvideEventBroker,
 		components.ProvideExecutionEngine,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
@@ -70,6 +72,7 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 
 	return c
@@ -77,12 +77,26 @@ type BaseConfig struct {
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 }
 
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the interval at which state sync snapshots are
+	// taken. 0 disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to
+	// keep and serve (0 keeps all).
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+}
+
 // Config defines the server's top level configuration.
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
+
+	// StateSync defines the state sync snapshot configuration.
+	StateSync StateSyncConfig `mapstructure:"state-sync"`
 }
 
 // DefaultConfig returns server's default configuration.
@@ -102,6 +116,11 @@ func DefaultConfig() *Config {
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval: 0,
+			//nolint:mnd // keep a couple of snapshots around for peers.
+			SnapshotKeepRecent: 2,
+		},
 	}
 }
 
@@ -114,16 +133,16 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return *conf, nil
 }
 
-// ValidateBasic returns an error if min-gas-prices field is empty in
-// BaseConfig. Otherwise, it returns nil.
+// ValidateBasic returns an error if state sync snapshots are enabled while
+// pruning everything. Otherwise, it returns nil.
 func (c Config) ValidateBasic() error {
-	// if c.Pruning == pruningtypes.PruningOptionEverything &&
-	// c.StateSync.SnapshotInterval > 0 {
-	// 	return sdkerrors.ErrAppConfig.Wrapf(
-	// 		"cannot enable state sync snapshots with '%s' pruning setting",
-	// pruningtypes.PruningOptionEverything,
-	// 	)
-	// }
+	if c.Pruning == pruningtypes.PruningOptionEverything &&
+		c.StateSync.SnapshotInterval > 0 {
+		return fmt.Errorf(
+			"cannot enable state sync snapshots with '%s' pruning setting",
+			pruningtypes.PruningOptionEverything,
+		)
+	}
 
 	return nil
 }
@@ -67,6 +67,15 @@ const (
 
 	// Slashing.
 	defaultProportionalSlashingMultiplier = 1
+	defaultMinSlashingPenaltyQuotient     = 4096
+
+	// Proposer rewards, disabled by default.
+	defaultProposerRewardForkTime = 9999999999999999
+	defaultProposerRewardPerBlock = 0
+
+	// Liveness values.
+	defaultLivenessWindowEpochs         = 8
+	defaultMaxLivenessEjectionsPerEpoch = 4
 
 	// Capella values.
 	defaultMaxWithdrawalsPerPayload         = 16
@@ -80,6 +89,13 @@ const (
 	defaultBytesPerBlob                     = 131072
 	defaultKZGCommitmentInclusionProofDepth = 17
 
+	// Electra values.
+	defaultMinPerEpochConsolidationChurnLimit = 128e9
+	defaultConsolidationChurnLimitQuotient    = 65536
+	defaultMinPerEpochExitChurnLimit          = 128e9
+	defaultExitChurnLimitQuotient             = 65536
+	defaultMinValidatorWithdrawabilityDelay   = 1
+
 	// Berachain values.
 	defaultValidatorSetCap      = 256
 	defaultEVMInflationAddress  = "0x0000000000000000000000000000000000000000"
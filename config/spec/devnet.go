@@ -23,6 +23,7 @@ package spec
 import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -55,6 +56,10 @@ const (
 	// devnetEVMInflationPerBlockDeneb1 is the amount of native EVM balance (in units
 	// of Gwei) to be minted per EL block after the Deneb1 fork.
 	devnetEVMInflationPerBlockDeneb1 = 11 * params.GWei
+
+	// devnetMaxMissedSignaturesPerWindow is 90% of the blocks in the liveness
+	// window, as on mainnet, given the devnet slots per epoch.
+	devnetMaxMissedSignaturesPerWindow = 9 * defaultSlotsPerEpoch * defaultLivenessWindowEpochs / 10
 )
 
 // DevnetChainSpecData is the chain.SpecData for a devnet. It is similar to mainnet but
@@ -67,8 +72,11 @@ func DevnetChainSpecData() *chain.SpecData {
 
 	// Fork timings are set to facilitate local testing across fork versions.
 	specData.GenesisTime = devnetGenesisTime
-	specData.Deneb1ForkTime = devnetDeneb1ForkTime
-	specData.ElectraForkTime = devnetElectraForkTime
+	specData.Forks = []chain.ForkData{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+		{Name: "deneb1", Version: version.Deneb1(), Timestamp: devnetDeneb1ForkTime},
+		{Name: "electra", Version: version.Electra(), Timestamp: devnetElectraForkTime},
+	}
 
 	// EVM inflation is different from mainnet to test.
 	specData.EVMInflationAddressGenesis = common.NewExecutionAddressFromHex(devnetEVMInflationAddress)
@@ -83,6 +91,9 @@ func DevnetChainSpecData() *chain.SpecData {
 	specData.EjectionBalance = defaultEjectionBalance
 	specData.EffectiveBalanceIncrement = defaultEffectiveBalanceIncrement
 	specData.SlotsPerEpoch = defaultSlotsPerEpoch
+	specData.MinPerEpochConsolidationChurnLimit = devnetMaxStakeAmount
+	specData.MinPerEpochExitChurnLimit = devnetMaxStakeAmount
+	specData.MaxMissedSignaturesPerWindow = devnetMaxMissedSignaturesPerWindow
 
 	return specData
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -69,6 +70,18 @@ const (
 	// mainnetMaxBlobCommitmentsPerBlock is 4096 at genesis to match Ethereum mainnet.
 	mainnetMaxBlobCommitmentsPerBlock = defaultMaxBlobCommitmentsPerBlock
 
+	// mainnetMinPerEpochConsolidationChurnLimit is the max stake of 10 million BERA, so that
+	// at least one fully staked validator can be consolidated every epoch.
+	mainnetMinPerEpochConsolidationChurnLimit = mainnetMaxEffectiveBalance
+
+	// mainnetMinPerEpochExitChurnLimit is the max stake of 10 million BERA, so that at
+	// least one fully staked validator can exit every epoch.
+	mainnetMinPerEpochExitChurnLimit = mainnetMaxEffectiveBalance
+
+	// mainnetMaxMissedSignaturesPerWindow is 90% of the blocks in the liveness
+	// window, so that validators are ejected once they sign less than 10% of them.
+	mainnetMaxMissedSignaturesPerWindow = 9 * mainnetSlotsPerEpoch * defaultLivenessWindowEpochs / 10
+
 	// The deposit contract address on mainnet at genesis is the same as the
 	// default deposit contract address.
 	mainnetDepositContractAddress = defaultDepositContractAddress
@@ -125,9 +138,12 @@ func MainnetChainSpecData() *chain.SpecData {
 		TargetSecondsPerEth1Block: defaultTargetSecondsPerEth1Block,
 
 		// Fork-related values.
-		GenesisTime:     mainnetGenesisTime,
-		Deneb1ForkTime:  mainnetDeneb1ForkTime,
-		ElectraForkTime: defaultElectraForkTime,
+		GenesisTime: mainnetGenesisTime,
+		Forks: []chain.ForkData{
+			{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+			{Name: "deneb1", Version: version.Deneb1(), Timestamp: mainnetDeneb1ForkTime},
+			{Name: "electra", Version: version.Electra(), Timestamp: defaultElectraForkTime},
+		},
 
 		// State list length constants.
 		EpochsPerHistoricalVector: defaultEpochsPerHistoricalVector,
@@ -135,6 +151,12 @@ func MainnetChainSpecData() *chain.SpecData {
 		HistoricalRootsLimit:      defaultHistoricalRootsLimit,
 		ValidatorRegistryLimit:    defaultValidatorRegistryLimit,
 
+		// Rewards and penalties constants.
+		ProportionalSlashingMultiplier: defaultProportionalSlashingMultiplier,
+		MinSlashingPenaltyQuotient:     defaultMinSlashingPenaltyQuotient,
+		ProposerRewardForkTime:         defaultProposerRewardForkTime,
+		ProposerRewardPerBlock:         defaultProposerRewardPerBlock,
+
 		// Capella values.
 		MaxWithdrawalsPerPayload:         defaultMaxWithdrawalsPerPayload,
 		MaxValidatorsPerWithdrawalsSweep: mainnetMaxValidatorsPerWithdrawalsSweep,
@@ -147,6 +169,18 @@ func MainnetChainSpecData() *chain.SpecData {
 		BytesPerBlob:                     defaultBytesPerBlob,
 		KZGCommitmentInclusionProofDepth: defaultKZGCommitmentInclusionProofDepth,
 
+		// Electra values.
+		MinPerEpochConsolidationChurnLimit: mainnetMinPerEpochConsolidationChurnLimit,
+		ConsolidationChurnLimitQuotient:    defaultConsolidationChurnLimitQuotient,
+		MinPerEpochExitChurnLimit:          mainnetMinPerEpochExitChurnLimit,
+		ExitChurnLimitQuotient:             defaultExitChurnLimitQuotient,
+		MinValidatorWithdrawabilityDelay:   defaultMinValidatorWithdrawabilityDelay,
+
+		// Liveness values.
+		LivenessWindowEpochs:         defaultLivenessWindowEpochs,
+		MaxMissedSignaturesPerWindow: mainnetMaxMissedSignaturesPerWindow,
+		MaxLivenessEjectionsPerEpoch: defaultMaxLivenessEjectionsPerEpoch,
+
 		// Berachain values at genesis.
 		ValidatorSetCap:             mainnetValidatorSetCap,
 		EVMInflationAddressGenesis:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
@@ -20,7 +20,10 @@
 
 package spec
 
-import "github.com/berachain/beacon-kit/chain"
+import (
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
 
 // TestnetChainSpecData is the chain.SpecData for Berachain's public testnet.
 func TestnetChainSpecData() *chain.SpecData {
@@ -35,7 +38,11 @@ func TestnetChainSpecData() *chain.SpecData {
 	// Deneb1 fork timing on Bepolia. This is calculated based on the timestamp of the first bepolia
 	// epoch, block 192, which was used to initiate the fork when beacon-kit forked by epoch instead
 	// of by timestamp.
-	specData.Deneb1ForkTime = 1740090694
+	specData.Forks = []chain.ForkData{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+		{Name: "deneb1", Version: version.Deneb1(), Timestamp: 1740090694},
+		{Name: "electra", Version: version.Electra(), Timestamp: defaultElectraForkTime},
+	}
 
 	return specData
 }
@@ -92,8 +92,9 @@ enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPaylo
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 
-# AvailabilityWindow is the number of slots to keep in the store.
-availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
+# BlockRetention is the number of most recent slots for which the full signed
+# beacon blocks are kept on disk. Set to 0 to keep every block.
+block-retention = "{{ .BeaconKit.BlockStoreService.BlockRetention }}"
 
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
@@ -86,8 +86,9 @@ type BeaconBlockBody struct {
 	attestations []*Attestation
 	// Deposits is the list of deposits included in the body.
 	Deposits []*Deposit
-	// voluntaryExits is unused but left for compatibility.
-	voluntaryExits []*VoluntaryExit
+	// voluntaryExits is the list of signed voluntary exits included in the
+	// body. It is introduced in electra and must be empty before.
+	voluntaryExits []*SignedVoluntaryExit
 	// syncAggregate is unused but left for compatibility.
 	syncAggregate *SyncAggregate
 	// ExecutionPayload is the execution payload of the body.
@@ -174,13 +175,15 @@ func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
 		b.GetProposerSlashings(),
 		b.GetAttesterSlashings(),
 		b.GetAttestations(),
-		b.GetVoluntaryExits(),
 		b.GetSyncAggregate(),
 		b.GetBlsToExecutionChanges(),
 	)
 	if err != nil {
 		return []byte{}, err
 	}
+	if err = b.enforceVoluntaryExitsFork(); err != nil {
+		return []byte{}, err
+	}
 	buf := make([]byte, ssz.Size(b))
 	return buf, ssz.EncodeToBytes(buf, b)
 }
@@ -199,16 +202,25 @@ func (b *BeaconBlockBody) ValidateAfterDecodingSSZ() error {
 		b.GetProposerSlashings(),
 		b.GetAttesterSlashings(),
 		b.GetAttestations(),
-		b.GetVoluntaryExits(),
 		b.GetSyncAggregate(),
 		b.GetBlsToExecutionChanges(),
 	)
 	return errors.Join(
 		b.ExecutionPayload.ValidateAfterDecodingSSZ(),
 		errUnused,
+		b.enforceVoluntaryExitsFork(),
 	)
 }
 
+// enforceVoluntaryExitsFork ensures that voluntary exits are only included
+// from Electra onwards.
+func (b *BeaconBlockBody) enforceVoluntaryExitsFork() error {
+	if len(b.voluntaryExits) != 0 && version.IsBefore(b.GetForkVersion(), version.Electra()) {
+		return errors.Wrapf(ErrFieldNotSupportedOnFork, "voluntary exits, block version %d", b.GetForkVersion())
+	}
+	return nil
+}
+
 // HashTreeRoot returns the SSZ hash tree root of the BeaconBlockBody.
 func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(b)
@@ -253,26 +253,40 @@ func TestBeaconBlockBody_UnusedAttestationsEnforcement(t *testing.T) {
 	})
 }
 
-// Ensure that the VoluntaryExits field cannot be unmarshaled with data in it,
-// enforcing that it's unused.
-func TestBeaconBlockBody_UnusedVoluntaryExitsEnforcement(t *testing.T) {
+// Ensure that the VoluntaryExits field cannot be unmarshaled with data in it
+// before Electra, and that it round trips from Electra onwards.
+func TestBeaconBlockBody_VoluntaryExitsEnforcement(t *testing.T) {
 	t.Parallel()
 	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
-		blockBody := types.BeaconBlockBody{
-			Versionable: types.NewVersionable(v),
+		body := generateBeaconBlockBody(t, v)
+		blockBody := &body
+		blockBody.SetVoluntaryExits(types.VoluntaryExits{
+			{
+				Message:   &types.VoluntaryExit{Epoch: 3, ValidatorIndex: 7},
+				Signature: crypto.BLSSignature{0x01},
+			},
+		})
+
+		if version.IsBefore(v, version.Electra()) {
+			_, err := blockBody.MarshalSSZ()
+			require.ErrorIs(t, err, types.ErrFieldNotSupportedOnFork)
+
+			buf := make([]byte, ssz.Size(blockBody))
+			err = ssz.EncodeToBytes(buf, blockBody)
+			require.NoError(t, err)
+
+			unmarshalledBody := types.NewEmptyBeaconBlockBodyWithVersion(v)
+			err = sszutil.Unmarshal(buf, unmarshalledBody)
+			require.ErrorIs(t, err, types.ErrFieldNotSupportedOnFork)
+			return
 		}
-		unused := common.UnusedType(1)
-		blockBody.SetVoluntaryExits(types.VoluntaryExits{&unused})
-		_, err := blockBody.MarshalSSZ()
-		require.Error(t, err)
 
-		buf := make([]byte, ssz.Size(&blockBody))
-		err = ssz.EncodeToBytes(buf, &blockBody)
+		buf, err := blockBody.MarshalSSZ()
 		require.NoError(t, err)
-
 		unmarshalledBody := types.NewEmptyBeaconBlockBodyWithVersion(v)
-		err = sszutil.Unmarshal(buf, unmarshalledBody)
-		require.ErrorContains(t, err, "must be unused")
+		require.NoError(t, sszutil.Unmarshal(buf, unmarshalledBody))
+		require.Equal(t, blockBody.GetVoluntaryExits(), unmarshalledBody.GetVoluntaryExits())
+		require.Equal(t, blockBody.HashTreeRoot(), unmarshalledBody.HashTreeRoot())
 	})
 }
 
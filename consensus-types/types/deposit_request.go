@@ -28,7 +28,8 @@ import (
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 )
 
-// DepositRequest is introduced in EIP6110 which is currently not processed.
+// DepositRequest is introduced in EIP6110. From Electra, deposit requests replace
+// the deposits read from the deposit contract logs.
 type DepositRequest = Deposit
 
 // Compile-time check to ensure DepositRequests implements the necessary interfaces.
@@ -51,6 +51,47 @@ type Genesis struct {
 	// ExecutionPayloadHeader is the header of the execution payload
 	// in the genesis.
 	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+
+	// Restart is only set on a genesis exported from the state of an
+	// existing chain, to restart that chain. It marks the genesis as
+	// exported and carries the state which the deposits do not recreate.
+	Restart *GenesisRestart `json:"restart,omitempty"`
+}
+
+// GenesisRestart is the state of an existing chain carried by a genesis
+// exported from it. The deposits of such a genesis are all the deposits
+// included in the chain, while the validators are restored from the registry
+// below. Epochs are rebased so that the epoch of the exported state is the
+// genesis epoch of the restarted chain.
+type GenesisRestart struct {
+	// Eth1DepositIndex is the number of deposits included in the chain.
+	Eth1DepositIndex uint64 `json:"eth1_deposit_index"`
+
+	// DepositRoot is the root of the deposits included in the chain.
+	DepositRoot common.Root `json:"deposit_root"`
+
+	// NextWithdrawalIndex is the index of the next withdrawal.
+	NextWithdrawalIndex uint64 `json:"next_withdrawal_index"`
+
+	// NextWithdrawalValidatorIndex is the index of the validator the next
+	// withdrawals sweep starts from.
+	NextWithdrawalValidatorIndex math.ValidatorIndex `json:"next_withdrawal_validator_index"`
+
+	// Validators is the validator registry, including the validators which
+	// exited but are not withdrawn yet, in registry order.
+	Validators []*Validator `json:"validators"`
+
+	// Balances is the balance of each validator of the registry.
+	Balances []math.Gwei `json:"balances"`
+
+	// PendingPartialWithdrawals is the queue of the partial withdrawals.
+	PendingPartialWithdrawals []*PendingPartialWithdrawal `json:"pending_partial_withdrawals"`
+
+	// Slashings is the slashings vector, starting at the genesis epoch.
+	Slashings []math.Gwei `json:"slashings"`
+
+	// TotalSlashing is the sum of the slashings vector.
+	TotalSlashing math.Gwei `json:"total_slashing"`
 }
 
 // GetForkVersion returns the fork version in the genesis.
@@ -68,6 +109,12 @@ func (g *Genesis) GetExecutionPayloadHeader() *ExecutionPayloadHeader {
 	return g.ExecutionPayloadHeader
 }
 
+// GetRestart returns the restart state of an exported genesis, or nil if the
+// genesis was not exported from an existing chain.
+func (g *Genesis) GetRestart() *GenesisRestart {
+	return g.Restart
+}
+
 // UnmarshalJSON for Genesis.
 func (g *Genesis) UnmarshalJSON(
 	data []byte,
@@ -76,6 +123,7 @@ func (g *Genesis) UnmarshalJSON(
 		ForkVersion            common.Version  `json:"fork_version"`
 		Deposits               []*Deposit      `json:"deposits"`
 		ExecutionPayloadHeader json.RawMessage `json:"execution_payload_header"`
+		Restart                *GenesisRestart `json:"restart,omitempty"`
 	}
 	var g2 genesisMarshalable[Deposit]
 	if err := json.Unmarshal(data, &g2); err != nil {
@@ -90,6 +138,7 @@ func (g *Genesis) UnmarshalJSON(
 	g.Deposits = g2.Deposits
 	g.ForkVersion = g2.ForkVersion
 	g.ExecutionPayloadHeader = payloadHeader
+	g.Restart = g2.Restart
 	return nil
 }
 
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// sszPendingConsolidationSize defines the total SSZ serialized size for
+// PendingConsolidation. The fields are assumed to be encoded as follows:
+// - SourceIndex: 8 bytes (uint64)
+// - TargetIndex: 8 bytes (uint64)
+// Total = 8 + 8 = 16 bytes.
+const sszPendingConsolidationSize = 16
+
+// Compile-time check to ensure PendingConsolidation and PendingConsolidations implements the necessary interfaces.
+var (
+	_ ssz.StaticObject            = (*PendingConsolidation)(nil)
+	_ constraints.SSZMarshallable = (*PendingConsolidation)(nil)
+
+	_ ssz.DynamicObject           = (*PendingConsolidations)(nil)
+	_ constraints.SSZMarshallable = (*PendingConsolidations)(nil)
+)
+
+// PendingConsolidation reflects the following spec:
+//
+//	class PendingConsolidation(Container):
+//	    source_index: ValidatorIndex
+//	    target_index: ValidatorIndex
+type PendingConsolidation struct {
+	SourceIndex math.ValidatorIndex
+	TargetIndex math.ValidatorIndex
+}
+
+/* -------------------------------------------------------------------------- */
+/*                        PendingConsolidation SSZ                            */
+/* -------------------------------------------------------------------------- */
+
+// ValidateAfterDecodingSSZ validates the PendingConsolidation object
+// after decoding from SSZ.
+func (p *PendingConsolidation) ValidateAfterDecodingSSZ() error {
+	return nil
+}
+
+// DefineSSZ registers the SSZ encoding for each field in PendingConsolidation.
+func (p *PendingConsolidation) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &p.SourceIndex)
+	ssz.DefineUint64(codec, &p.TargetIndex)
+}
+
+// SizeSSZ returns the fixed size of the SSZ serialization for PendingConsolidation.
+func (p *PendingConsolidation) SizeSSZ(_ *ssz.Sizer) uint32 {
+	return sszPendingConsolidationSize
+}
+
+// MarshalSSZ returns the SSZ encoding of the PendingConsolidation.
+func (p *PendingConsolidation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(p))
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// HashTreeRoot computes and returns the hash tree root for the PendingConsolidation.
+func (p *PendingConsolidation) HashTreeRoot() common.Root {
+	return ssz.HashSequential(p)
+}
+
+// HashTreeRootWith SSZ hashes the PendingConsolidation object with a hasher. Needed for BeaconState SSZ.
+func (p *PendingConsolidation) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceIndex'
+	hh.PutUint64(uint64(p.SourceIndex))
+
+	// Field (1) 'TargetIndex'
+	hh.PutUint64(uint64(p.TargetIndex))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// PendingConsolidations is a SSZ list of PendingConsolidation containers.
+type PendingConsolidations []*PendingConsolidation
+
+// NewEmptyPendingConsolidations returns a new empty PendingConsolidations list.
+func NewEmptyPendingConsolidations() *PendingConsolidations {
+	return &PendingConsolidations{}
+}
+
+// DefineSSZ defines the SSZ encoding for the PendingConsolidations list.
+func (p *PendingConsolidations) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticObjectsOffset(codec, (*[]*PendingConsolidation)(p), constants.PendingConsolidationsLimit)
+	ssz.DefineSliceOfStaticObjectsContent(codec, (*[]*PendingConsolidation)(p), constants.PendingConsolidationsLimit)
+}
+
+// SizeSSZ returns the size of the PendingConsolidations list.
+func (p *PendingConsolidations) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	if fixed {
+		return constants.SSZOffsetSize
+	}
+	return constants.SSZOffsetSize + ssz.SizeSliceOfStaticObjects(siz, *p)
+}
+
+// MarshalSSZ returns the SSZ encoding of the PendingConsolidations list.
+func (p *PendingConsolidations) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(p))
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// ValidateAfterDecodingSSZ validates the PendingConsolidations list after decoding from SSZ.
+func (p *PendingConsolidations) ValidateAfterDecodingSSZ() error {
+	if p == nil {
+		return errors.New("nil PendingConsolidations")
+	}
+	if len(*p) > constants.PendingConsolidationsLimit {
+		return errors.New("pending consolidations too large")
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	prysmtypes "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingConsolidation_ValidValuesSSZ(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		pending *types.PendingConsolidation
+	}{
+		{
+			name:    "basic",
+			pending: &types.PendingConsolidation{SourceIndex: 1, TargetIndex: 2},
+		},
+		{
+			name:    "zero values",
+			pending: &types.PendingConsolidation{},
+		},
+		{
+			name:    "max values",
+			pending: &types.PendingConsolidation{SourceIndex: 1<<64 - 1, TargetIndex: 1<<64 - 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Marshal the original pending consolidation.
+			pendingBytes, err := tc.pending.MarshalSSZ()
+			require.NoError(t, err)
+
+			// Unmarshal into the prysm type.
+			var prysmType prysmtypes.PendingConsolidation
+			err = prysmType.UnmarshalSSZ(pendingBytes)
+			require.NoError(t, err)
+
+			// Compare the HashTreeRoots.
+			originalHTR := tc.pending.HashTreeRoot()
+			prysmHTR, err := prysmType.HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, originalHTR[:], prysmHTR[:])
+
+			// Marshal the prysm type and unmarshal back into the original type.
+			prysmBytes, err := prysmType.MarshalSSZ()
+			require.NoError(t, err)
+			var recomputed types.PendingConsolidation
+			err = ssz.Unmarshal(prysmBytes, &recomputed)
+			require.NoError(t, err)
+			require.Equal(t, *tc.pending, recomputed)
+		})
+	}
+}
+
+func TestPendingConsolidations_ValidValuesSSZ(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name    string
+		pending *types.PendingConsolidations
+	}{
+		{
+			name:    "empty slice",
+			pending: types.NewEmptyPendingConsolidations(),
+		},
+		{
+			name: "multiple elements",
+			pending: &types.PendingConsolidations{
+				&types.PendingConsolidation{SourceIndex: 1, TargetIndex: 2},
+				&types.PendingConsolidation{SourceIndex: 3, TargetIndex: 2},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pendingBytes, err := tc.pending.MarshalSSZ()
+			require.NoError(t, err)
+
+			var recomputed types.PendingConsolidations
+			err = ssz.Unmarshal(pendingBytes, &recomputed)
+			require.NoError(t, err)
+			require.Equal(t, tc.pending, &recomputed)
+		})
+	}
+}
+
+//nolint:paralleltest // Invalid SSZ payloads rely on shared zeroalloc
+func TestPendingConsolidation_InvalidValuesUnmarshalSSZ(t *testing.T) {
+	validPending := &types.PendingConsolidation{SourceIndex: 1, TargetIndex: 2}
+	validBytes, err := validPending.MarshalSSZ()
+	require.NoError(t, err)
+
+	invalidPayloads := [][]byte{
+		nil,                       // nil slice
+		{},                        // empty slice
+		[]byte("this is not ssz"), // arbitrary non-SSZ data
+		validBytes[:len(validBytes)-5],
+		append(validBytes, 0xAA, 0xBB),
+	}
+
+	for i, payload := range invalidPayloads {
+		t.Run(fmt.Sprintf("invalidPendingConsolidation_%d", i), func(t *testing.T) {
+			require.NotPanics(t, func() {
+				var p types.PendingConsolidation
+				err = ssz.Unmarshal(payload, &p)
+				require.Error(t, err, "expected error for payload %v", payload)
+			})
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time check to ensure PendingDeposits implements the necessary interfaces.
+var (
+	_ ssz.DynamicObject           = (*PendingDeposits)(nil)
+	_ constraints.SSZMarshallable = (*PendingDeposits)(nil)
+)
+
+// PendingDeposits is a SSZ list of the EIP-6110 deposit requests waiting for
+// the legacy deposits preceding them to be processed.
+//
+// NOTE: Modified from the Ethereum 2.0 specification, which queues
+// PendingDeposit containers carrying the slot of the request. Pending deposits
+// are applied as soon as the legacy deposits are drained in beacon-kit, so the
+// deposit request is queued as is, keeping its deposit index instead.
+type PendingDeposits []*Deposit
+
+// NewEmptyPendingDeposits returns a new empty PendingDeposits list.
+func NewEmptyPendingDeposits() *PendingDeposits {
+	return &PendingDeposits{}
+}
+
+// DefineSSZ defines the SSZ encoding for the PendingDeposits list.
+func (p *PendingDeposits) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticObjectsOffset(codec, (*[]*Deposit)(p), constants.PendingDepositsLimit)
+	ssz.DefineSliceOfStaticObjectsContent(codec, (*[]*Deposit)(p), constants.PendingDepositsLimit)
+}
+
+// SizeSSZ returns the size of the PendingDeposits list.
+func (p *PendingDeposits) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	if fixed {
+		return constants.SSZOffsetSize
+	}
+	return constants.SSZOffsetSize + ssz.SizeSliceOfStaticObjects(siz, *p)
+}
+
+// MarshalSSZ returns the SSZ encoding of the PendingDeposits list.
+func (p *PendingDeposits) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(p))
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// ValidateAfterDecodingSSZ validates the PendingDeposits list after decoding from SSZ.
+func (p *PendingDeposits) ValidateAfterDecodingSSZ() error {
+	if p == nil {
+		return errors.New("nil PendingDeposits")
+	}
+	if len(*p) > constants.PendingDepositsLimit {
+		return errors.New("pending deposits too large")
+	}
+	return nil
+}
@@ -54,9 +54,9 @@ var (
 //	    amount: Gwei
 //	    withdrawable_epoch: Epoch
 type PendingPartialWithdrawal struct {
-	ValidatorIndex    math.ValidatorIndex
-	Amount            math.Gwei
-	WithdrawableEpoch math.Epoch
+	ValidatorIndex    math.ValidatorIndex `json:"validator_index"`
+	Amount            math.Gwei           `json:"amount"`
+	WithdrawableEpoch math.Epoch          `json:"withdrawable_epoch"`
 }
 
 /* -------------------------------------------------------------------------- */
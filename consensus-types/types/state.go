@@ -66,6 +66,21 @@ type BeaconState struct {
 
 	// PendingPartialWithdrawals is introduced in electra
 	PendingPartialWithdrawals []*PendingPartialWithdrawal `json:"pending_partial_withdrawals,omitempty"`
+
+	// Consolidations are introduced in electra
+	ConsolidationBalanceToConsume math.Gwei               `json:"consolidation_balance_to_consume,omitempty"`
+	EarliestConsolidationEpoch    math.Epoch              `json:"earliest_consolidation_epoch,omitempty"`
+	PendingConsolidations         []*PendingConsolidation `json:"pending_consolidations,omitempty"`
+
+	// DepositRequestsStartIndex is introduced in electra
+	DepositRequestsStartIndex uint64 `json:"deposit_requests_start_index,omitempty"`
+
+	// Exit churn is introduced in electra
+	ExitBalanceToConsume math.Gwei  `json:"exit_balance_to_consume,omitempty"`
+	EarliestExitEpoch    math.Epoch `json:"earliest_exit_epoch,omitempty"`
+
+	// PendingDeposits is introduced in electra
+	PendingDeposits []*Deposit `json:"pending_deposits,omitempty"`
 }
 
 // NewEmptyBeaconStateWithVersion returns a new empty BeaconState with the given fork version.
@@ -101,12 +116,19 @@ func (st *BeaconState) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 
 		// Electra Fork
 		PendingPartialWithdrawals = 4 (Dynamic field)
+		ConsolidationBalanceToConsume = 8
+		EarliestConsolidationEpoch = 8
+		PendingConsolidations = 4 (Dynamic field)
+		DepositRequestsStartIndex = 8
+		ExitBalanceToConsume = 8
+		EarliestExitEpoch = 8
+		PendingDeposits = 4 (Dynamic field)
 	*/
 	var size uint32 = 300
 
 	if version.EqualsOrIsAfter(st.GetForkVersion(), version.Electra()) {
-		// Add 4 for PendingPartialWithdrawals after Electra
-		size += 4
+		// Add 4 + 8 + 8 + 4 + 8 + 8 + 8 + 4 for the fields introduced in Electra
+		size += 52
 	}
 
 	if fixed {
@@ -123,6 +145,8 @@ func (st *BeaconState) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfUint64s(siz, st.Slashings)
 	if version.EqualsOrIsAfter(st.GetForkVersion(), version.Electra()) {
 		size += ssz.SizeSliceOfStaticObjects(siz, st.PendingPartialWithdrawals)
+		size += ssz.SizeSliceOfStaticObjects(siz, st.PendingConsolidations)
+		size += ssz.SizeSliceOfStaticObjects(siz, st.PendingDeposits)
 	}
 
 	return size
@@ -162,9 +186,16 @@ func (st *BeaconState) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
-	// Electra Withdrawals
+	// Electra Withdrawals, Consolidations, Deposit Requests and Exits
 	if version.EqualsOrIsAfter(st.GetForkVersion(), version.Electra()) {
 		ssz.DefineSliceOfStaticObjectsOffset(codec, &st.PendingPartialWithdrawals, constants.PendingPartialWithdrawalsLimit)
+		ssz.DefineUint64(codec, &st.ConsolidationBalanceToConsume)
+		ssz.DefineUint64(codec, &st.EarliestConsolidationEpoch)
+		ssz.DefineSliceOfStaticObjectsOffset(codec, &st.PendingConsolidations, constants.PendingConsolidationsLimit)
+		ssz.DefineUint64(codec, &st.DepositRequestsStartIndex)
+		ssz.DefineUint64(codec, &st.ExitBalanceToConsume)
+		ssz.DefineUint64(codec, &st.EarliestExitEpoch)
+		ssz.DefineSliceOfStaticObjectsOffset(codec, &st.PendingDeposits, constants.PendingDepositsLimit)
 	}
 
 	// Dynamic content
@@ -175,9 +206,11 @@ func (st *BeaconState) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
-	// Electra Withdrawals
+	// Electra Withdrawals, Consolidations and Deposit Requests
 	if version.EqualsOrIsAfter(st.GetForkVersion(), version.Electra()) {
 		ssz.DefineSliceOfStaticObjectsContent(codec, &st.PendingPartialWithdrawals, constants.PendingPartialWithdrawalsLimit)
+		ssz.DefineSliceOfStaticObjectsContent(codec, &st.PendingConsolidations, constants.PendingConsolidationsLimit)
+		ssz.DefineSliceOfStaticObjectsContent(codec, &st.PendingDeposits, constants.PendingDepositsLimit)
 	}
 }
 
@@ -359,6 +392,47 @@ func (st *BeaconState) HashTreeRootWith(
 			}
 		}
 		hh.MerkleizeWithMixin(subIndx, numPPW, constants.PendingPartialWithdrawalsLimit)
+
+		// Field (17) 'ConsolidationBalanceToConsume'
+		hh.PutUint64(uint64(st.ConsolidationBalanceToConsume))
+
+		// Field (18) 'EarliestConsolidationEpoch'
+		hh.PutUint64(uint64(st.EarliestConsolidationEpoch))
+
+		// Field (19) 'PendingConsolidations'
+		subIndx = hh.Index()
+		numPC := uint64(len(st.PendingConsolidations))
+		if numPC > constants.PendingConsolidationsLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.PendingConsolidations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, numPC, constants.PendingConsolidationsLimit)
+
+		// Field (20) 'DepositRequestsStartIndex'
+		hh.PutUint64(st.DepositRequestsStartIndex)
+
+		// Field (21) 'ExitBalanceToConsume'
+		hh.PutUint64(uint64(st.ExitBalanceToConsume))
+
+		// Field (22) 'EarliestExitEpoch'
+		hh.PutUint64(uint64(st.EarliestExitEpoch))
+
+		// Field (23) 'PendingDeposits'
+		subIndx = hh.Index()
+		numPD := uint64(len(st.PendingDeposits))
+		if numPD > constants.PendingDepositsLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.PendingDeposits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, numPD, constants.PendingDepositsLimit)
 	}
 	hh.Merkleize(indx)
 	return nil
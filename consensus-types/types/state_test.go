@@ -125,6 +125,26 @@ func generateValidBeaconState(forkVersion common.Version) *types.BeaconState {
 				WithdrawableEpoch: 1,
 			},
 		}
+		beaconState.ConsolidationBalanceToConsume = 64000000000
+		beaconState.EarliestConsolidationEpoch = 7
+		beaconState.PendingConsolidations = []*types.PendingConsolidation{
+			{
+				SourceIndex: 125,
+				TargetIndex: 123,
+			},
+		}
+		beaconState.DepositRequestsStartIndex = 42
+		beaconState.ExitBalanceToConsume = 32000000000
+		beaconState.EarliestExitEpoch = 9
+		beaconState.PendingDeposits = []*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: types.WithdrawalCredentials{0x01},
+				Amount:      32000000000,
+				Signature:   [96]byte{0x02},
+				Index:       43,
+			},
+		}
 	}
 	return beaconState
 }
@@ -238,23 +238,31 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specification:
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-is_fully_withdrawable_validator
 func (v Validator) IsFullyWithdrawable(
 	balance math.Gwei,
 	epoch math.Epoch,
 ) bool {
-	return v.HasEth1WithdrawalCredentials() && v.WithdrawableEpoch <= epoch &&
+	return v.HasExecutionWithdrawalCredentials() && v.WithdrawableEpoch <= epoch &&
 		balance > 0
 }
 
 // IsPartiallyWithdrawable as defined in the Ethereum 2.0 specification:
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_partially_withdrawable_validator
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-is_partially_withdrawable_validator
+//
+// NOTE: beacon-kit has a single max effective balance for both eth1 and
+// compounding withdrawal credentials.
 func (v Validator) IsPartiallyWithdrawable(
 	balance, maxEffectiveBalance math.Gwei,
 ) bool {
 	hasExcessBalance := balance > maxEffectiveBalance
-	return v.HasEth1WithdrawalCredentials() &&
+	return v.HasExecutionWithdrawalCredentials() &&
 		v.HasMaxEffectiveBalance(maxEffectiveBalance) && hasExcessBalance
 }
 
@@ -264,6 +272,23 @@ func (v Validator) HasEth1WithdrawalCredentials() bool {
 	return v.WithdrawalCredentials.IsValidEth1WithdrawalCredentials()
 }
 
+// HasCompoundingWithdrawalCredentials as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_compounding_withdrawal_credential
+func (v Validator) HasCompoundingWithdrawalCredentials() bool {
+	return v.WithdrawalCredentials.IsValidCompoundingWithdrawalCredentials()
+}
+
+// HasExecutionWithdrawalCredentials as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+func (v Validator) HasExecutionWithdrawalCredentials() bool {
+	return v.WithdrawalCredentials.IsValidExecutionWithdrawalCredentials()
+}
+
+// SetWithdrawalCredentials sets the withdrawal credentials of the validator.
+func (v *Validator) SetWithdrawalCredentials(wc WithdrawalCredentials) {
+	v.WithdrawalCredentials = wc
+}
+
 // HasMaxEffectiveBalance determines if the validator has the maximum effective
 // balance.
 func (v Validator) HasMaxEffectiveBalance(
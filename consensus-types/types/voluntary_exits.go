@@ -17,29 +17,115 @@
 // EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
-
-//nolint:dupl // separate file for ease of future implementation
 package types
 
 import (
-	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/karalabe/ssz"
 )
 
-// Compile-time assertions to ensure VoluntaryExit implements necessary interfaces.
+const (
+	// sszVoluntaryExitSize is the size of the VoluntaryExit object in bytes.
+	// Epoch (8) + ValidatorIndex (8).
+	sszVoluntaryExitSize = 16
+
+	// sszSignedVoluntaryExitSize is the size of the SignedVoluntaryExit object
+	// in bytes. Message (16) + Signature (96).
+	sszSignedVoluntaryExitSize = 112
+)
+
+// Compile-time assertions to ensure VoluntaryExit and SignedVoluntaryExit implement necessary interfaces.
 var (
 	_ ssz.StaticObject                    = (*VoluntaryExit)(nil)
 	_ constraints.SSZMarshallableRootable = (*VoluntaryExit)(nil)
-	_ common.UnusedEnforcer               = (*VoluntaryExits)(nil)
+	_ ssz.StaticObject                    = (*SignedVoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedVoluntaryExit)(nil)
 )
 
-type (
-	VoluntaryExit  = common.UnusedType
-	VoluntaryExits []*VoluntaryExit
-)
+// VoluntaryExit reflects the following spec:
+//
+//	class VoluntaryExit(Container):
+//	    epoch: Epoch  # Earliest epoch when voluntary exit can be processed
+//	    validator_index: ValidatorIndex
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit can be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is a VoluntaryExit signed by the exiting validator.
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit      `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// VoluntaryExits is a list of signed voluntary exits included in a block body.
+type VoluntaryExits []*SignedVoluntaryExit
+
+/* -------------------------------------------------------------------------- */
+/*                              VoluntaryExit SSZ                             */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size in bytes for the VoluntaryExit.
+func (*VoluntaryExit) SizeSSZ(*ssz.Sizer) uint32 {
+	return sszVoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExit object.
+func (v *VoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &v.Epoch)
+	ssz.DefineUint64(codec, &v.ValidatorIndex)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(v))
+	return buf, ssz.EncodeToBytes(buf, v)
+}
+
+func (*VoluntaryExit) ValidateAfterDecodingSSZ() error { return nil }
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExit object.
+func (v *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(v)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                           SignedVoluntaryExit SSZ                          */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size in bytes for the SignedVoluntaryExit.
+func (*SignedVoluntaryExit) SizeSSZ(*ssz.Sizer) uint32 {
+	return sszSignedVoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Message)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+func (*SignedVoluntaryExit) ValidateAfterDecodingSSZ() error { return nil }
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             VoluntaryExits SSZ                             */
+/* -------------------------------------------------------------------------- */
 
 // SizeSSZ returns the SSZ encoded size in bytes for the VoluntaryExits.
 func (vs VoluntaryExits) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
@@ -49,13 +135,13 @@ func (vs VoluntaryExits) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
 // DefineSSZ defines the SSZ encoding for the VoluntaryExits object.
 func (vs VoluntaryExits) DefineSSZ(c *ssz.Codec) {
 	c.DefineDecoder(func(*ssz.Decoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 	c.DefineEncoder(func(*ssz.Encoder) {
-		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsContent(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 	c.DefineHasher(func(*ssz.Hasher) {
-		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*VoluntaryExit)(&vs), constants.MaxVoluntaryExits)
+		ssz.DefineSliceOfStaticObjectsOffset(c, (*[]*SignedVoluntaryExit)(&vs), constants.MaxVoluntaryExits)
 	})
 }
 
@@ -63,13 +149,3 @@ func (vs VoluntaryExits) DefineSSZ(c *ssz.Codec) {
 func (vs VoluntaryExits) HashTreeRoot() common.Root {
 	return ssz.HashSequential(vs)
 }
-
-// EnforceUnused return true if the length of the VoluntaryExits is 0.
-// As long as this type remains unimplemented and unvalidated by consensus,
-// we must enforce that it contains no data.
-func (vs VoluntaryExits) EnforceUnused() error {
-	if len(vs) != 0 {
-		return errors.New("VoluntaryExits must be unused")
-	}
-	return nil
-}
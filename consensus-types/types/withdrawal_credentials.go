@@ -29,6 +29,10 @@ const (
 	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
 	EthSecp256k1CredentialPrefix = byte(1)
 
+	// CompoundingWithdrawalPrefix is the prefix for an Ethereum secp256k1
+	// with compounding, introduced in Electra.
+	CompoundingWithdrawalPrefix = byte(2)
+
 	// numZeroBytesInEth1WithdrawalCredentials is the number of zero bytes in a valid eth1
 	// withdrawal credentials.
 	numZeroBytesInEth1WithdrawalCredentials = 11
@@ -54,10 +58,32 @@ func (wc WithdrawalCredentials) IsValidEth1WithdrawalCredentials() bool {
 	return wc[0] == EthSecp256k1CredentialPrefix && bytes.Equal(wc[1:12], zeroBytes)
 }
 
+// IsValidCompoundingWithdrawalCredentials checks if the withdrawal credentials
+// are valid compounding withdrawal credentials as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-is_compounding_withdrawal_credential
+func (wc WithdrawalCredentials) IsValidCompoundingWithdrawalCredentials() bool {
+	zeroBytes := make([]byte, numZeroBytesInEth1WithdrawalCredentials)
+	return wc[0] == CompoundingWithdrawalPrefix && bytes.Equal(wc[1:12], zeroBytes)
+}
+
+// IsValidExecutionWithdrawalCredentials checks if the withdrawal credentials
+// are either valid eth1 or valid compounding withdrawal credentials.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+func (wc WithdrawalCredentials) IsValidExecutionWithdrawalCredentials() bool {
+	return wc.IsValidEth1WithdrawalCredentials() || wc.IsValidCompoundingWithdrawalCredentials()
+}
+
+// ToCompounding returns the WithdrawalCredentials with the compounding prefix.
+func (wc WithdrawalCredentials) ToCompounding() WithdrawalCredentials {
+	wc[0] = CompoundingWithdrawalPrefix
+	return wc
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 // Returns error if the withdrawal credentials are not valid.
 func (wc WithdrawalCredentials) ToExecutionAddress() (common.ExecutionAddress, error) {
-	if !wc.IsValidEth1WithdrawalCredentials() {
+	if !wc.IsValidExecutionWithdrawalCredentials() {
 		return common.ExecutionAddress{}, ErrInvalidWithdrawalCredentials
 	}
 	return common.ExecutionAddress(wc[12:]), nil
@@ -158,6 +158,25 @@ func TestToExecutionAddress_InvalidPrefix(t *testing.T) {
 	require.Error(t, err, "Expected an error due to invalid prefix")
 }
 
+func TestCompoundingWithdrawalCredentials(t *testing.T) {
+	t.Parallel()
+	address := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	eth1Credentials := types.NewCredentialsFromExecutionAddress(address)
+	require.False(t, eth1Credentials.IsValidCompoundingWithdrawalCredentials())
+	require.True(t, eth1Credentials.IsValidExecutionWithdrawalCredentials())
+
+	credentials := eth1Credentials.ToCompounding()
+	require.Equal(t, byte(0x02), credentials[0], "Expected prefix to be 0x02")
+	require.Equal(t, byte(0x01), eth1Credentials[0], "Expected original to be unchanged")
+	require.True(t, credentials.IsValidCompoundingWithdrawalCredentials())
+	require.False(t, credentials.IsValidEth1WithdrawalCredentials())
+	require.True(t, credentials.IsValidExecutionWithdrawalCredentials())
+
+	converted, err := credentials.ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, converted)
+}
+
 func TestWithdrawalCredentials_UnmarshalJSON(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
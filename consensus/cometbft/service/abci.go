@@ -139,45 +139,55 @@ func (s *Service) Commit(
 	return s.commit(req)
 }
 
-//
-// NOOP methods
-//
-
-func (Service) Query(
-	context.Context,
-	*abci.QueryRequest,
-) (*abci.QueryResponse, error) {
-	return &abci.QueryResponse{}, nil
-}
-
-func (Service) ListSnapshots(
+// ListSnapshots implements the ABCI interface. It returns the state-sync
+// snapshots available on this node.
+func (s *Service) ListSnapshots(
 	context.Context,
 	*abci.ListSnapshotsRequest,
 ) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
+	return s.listSnapshots()
 }
 
-func (Service) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
+// LoadSnapshotChunk implements the ABCI interface. It returns a chunk of a
+// state-sync snapshot for a node restoring from it.
+func (s *Service) LoadSnapshotChunk(
+	_ context.Context,
+	req *abci.LoadSnapshotChunkRequest,
 ) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
+	return s.loadSnapshotChunk(req)
 }
 
-func (Service) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
+// OfferSnapshot implements the ABCI interface. It starts restoring the
+// offered state-sync snapshot if this node can restore it.
+func (s *Service) OfferSnapshot(
+	_ context.Context,
+	req *abci.OfferSnapshotRequest,
 ) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
+	return s.offerSnapshot(req)
 }
 
-func (Service) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
+// ApplySnapshotChunk implements the ABCI interface. It applies a chunk of the
+// state-sync snapshot being restored.
+func (s *Service) ApplySnapshotChunk(
+	_ context.Context,
+	req *abci.ApplySnapshotChunkRequest,
 ) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
+	return s.applySnapshotChunk(req)
 }
 
+// Query implements the ABCI interface. It answers the beacon state queries
+// at the requested height, with proofs against the app hash if requested.
+func (s *Service) Query(
+	_ context.Context,
+	req *abci.QueryRequest,
+) (*abci.QueryResponse, error) {
+	return s.query(req), nil
+}
+
+//
+// NOOP methods
+//
+
 func (Service) ExtendVote(
 	context.Context,
 	*abci.ExtendVoteRequest,
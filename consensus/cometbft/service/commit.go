@@ -47,6 +47,10 @@ func (s *Service) commit(
 
 	s.finalizeBlockState = nil
 
+	// The snapshot manager takes the snapshot, if due at this height, in a
+	// goroutine reading the committed version of the stores.
+	s.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -118,6 +122,15 @@ func (s *Service) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	// Define the state snapshot retention range, keeping every block since
+	// the oldest snapshot still retained.
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(retentionHeight, commitHeight-snapshotRetentionHeights)
+		}
+	}
+
 	v := commitHeight - int64(s.minRetainBlocks) // #nosec G115
 	retentionHeight = minNonZero(retentionHeight, v)
 
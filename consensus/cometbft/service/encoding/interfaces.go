@@ -20,8 +20,17 @@
 
 package encoding
 
+import cmtabci "github.com/cometbft/cometbft/abci/types"
+
 // ABCIRequest represents the interface for an ABCI request.
 type ABCIRequest interface {
 	// GetTxs returns the transactions included in the request.
 	GetTxs() [][]byte
 }
+
+// MisbehaviorRequest represents an ABCI request carrying the validator
+// misbehaviors committed to by consensus.
+type MisbehaviorRequest interface {
+	// GetMisbehavior returns the misbehaviors included in the request.
+	GetMisbehavior() []cmtabci.Misbehavior
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+// ExtractMisbehaviorsFromRequest extracts the validator misbehaviors that
+// must be slashed from an ABCI request. Both duplicate votes and light client
+// attacks are reported per misbehaving validator, so each entry maps to a
+// single validator. Misbehaviors of unknown type are ignored.
+func ExtractMisbehaviorsFromRequest(
+	req MisbehaviorRequest,
+) []transition.Misbehavior {
+	if req == nil {
+		return nil
+	}
+
+	var misbehaviors []transition.Misbehavior
+	for _, m := range req.GetMisbehavior() {
+		switch m.Type {
+		case cmtabci.MISBEHAVIOR_TYPE_DUPLICATE_VOTE,
+			cmtabci.MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK:
+			misbehaviors = append(misbehaviors, transition.Misbehavior{
+				ValidatorAddress: m.Validator.Address,
+				Height:           m.Height,
+			})
+		default:
+			continue
+		}
+	}
+	return misbehaviors
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"github.com/berachain/beacon-kit/primitives/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// voteInfo is implemented by the votes of both the commit info and the
+// extended commit info of ABCI requests.
+type voteInfo[V any] interface {
+	*V
+	GetValidator() cmtabci.Validator
+	GetBlockIdFlag() cmtproto.BlockIDFlag
+}
+
+// ExtractVotes extracts the votes of the validators on the previous block
+// from the commit info, extended or not, of an ABCI request. A validator is
+// considered to have signed unless its vote is absent from the commit.
+func ExtractVotes[V any, PV voteInfo[V]](infoVotes []V) []transition.Vote {
+	votes := make([]transition.Vote, 0, len(infoVotes))
+	for i := range infoVotes {
+		v := PV(&infoVotes[i])
+		votes = append(votes, transition.Vote{
+			ValidatorAddress: v.GetValidator().Address,
+			Signed:           v.GetBlockIdFlag() != cmtproto.BlockIDFlagAbsent,
+		})
+	}
+	return votes
+}
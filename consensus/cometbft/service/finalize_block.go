@@ -21,6 +21,7 @@
 package cometbft
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -52,12 +53,20 @@ func (s *Service) finalizeBlockInternal(
 	// is nil, it means we are replaying this block and we need to set the state
 	// here given that during block replay ProcessProposal is not executed by
 	// CometBFT.
-	if s.finalizeBlockState == nil {
+	//
+	// If ProcessProposal accepted this very block, its state already holds the
+	// post-state of the block and is committed in place of finalizeBlockState.
+	switch {
+	case s.finalizeBlockState == nil:
 		s.finalizeBlockState = s.resetState(ctx)
-	} else {
+	case s.isAcceptedProposal(req):
+		s.finalizeBlockState = s.processProposalState
+		s.finalizeBlockState.SetContext(s.finalizeBlockState.Context().WithContext(ctx))
+	default:
 		// Preserve the CosmosSDK context while using the correct base ctx.
 		s.finalizeBlockState.SetContext(s.finalizeBlockState.Context().WithContext(ctx))
 	}
+	s.acceptedProposalHash = nil
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
@@ -170,3 +179,11 @@ func (s *Service) validateFinalizeBlockHeight(
 
 	return nil
 }
+
+// isAcceptedProposal returns whether the block to finalize is the one accepted
+// by the last ProcessProposal.
+func (s *Service) isAcceptedProposal(req *cmtabci.FinalizeBlockRequest) bool {
+	return s.acceptedProposalHash != nil &&
+		s.acceptedProposalHeight == req.Height &&
+		bytes.Equal(s.acceptedProposalHash, req.Hash)
+}
@@ -86,12 +86,19 @@ func (s *Service) ValidateGenesis(
 		)
 	}
 
-	if err := validateDeposits(beaconGenesis.GetDeposits()); err != nil {
-		return fmt.Errorf("invalid deposits: %w", err)
+	// A genesis exported from an existing chain is marked by its restart
+	// state, and is validated against the rules of an exported state.
+	restart := beaconGenesis.GetRestart()
+	if restart == nil {
+		if err := validateDeposits(beaconGenesis.GetDeposits()); err != nil {
+			return fmt.Errorf("invalid deposits: %w", err)
+		}
+	} else if err := validateRestart(beaconGenesis.GetDeposits(), restart); err != nil {
+		return fmt.Errorf("invalid restart state: %w", err)
 	}
 
 	if err := validateExecutionHeader(
-		beaconGenesis.GetExecutionPayloadHeader(),
+		beaconGenesis.GetExecutionPayloadHeader(), restart != nil,
 	); err != nil {
 		return fmt.Errorf("invalid execution payload header: %w", err)
 	}
@@ -129,34 +136,121 @@ func validateDeposits(deposits []*types.Deposit) error {
 	return nil
 }
 
+// validateRestart performs validation of the restart state of an exported
+// genesis. It ensures:
+// - At least one deposit is present, as the deposits are all the deposits
+// included in the exported chain and may top up the same validator
+// - At least one validator is restored, with no duplicate public keys
+// Returns an error with details if any validation fails.
+func validateRestart(deposits []*types.Deposit, restart *types.GenesisRestart) error {
+	if len(deposits) == 0 {
+		return errors.New("at least one deposit is required")
+	}
+	for i, deposit := range deposits {
+		if deposit == nil {
+			return fmt.Errorf("deposit %d is nil", i)
+		}
+	}
+
+	if len(restart.Validators) == 0 {
+		return errors.New("at least one validator is required")
+	}
+	seenPubkeys := make(map[string]struct{})
+	for i, val := range restart.Validators {
+		if val == nil {
+			return fmt.Errorf("validator %d is nil", i)
+		}
+		pubkeyHex := hex.EncodeToString(val.Pubkey[:])
+		if _, seen := seenPubkeys[pubkeyHex]; seen {
+			return fmt.Errorf("duplicate pubkey found in validator %d", i)
+		}
+		seenPubkeys[pubkeyHex] = struct{}{}
+	}
+	if len(restart.Balances) != len(restart.Validators) {
+		return fmt.Errorf(
+			"%d balances for %d validators", len(restart.Balances), len(restart.Validators),
+		)
+	}
+	return nil
+}
+
 // maxExtraDataSize defines the maximum allowed size in bytes for the ExtraData
 // field in the execution payload header.
 const maxExtraDataSize = 32
 
-// validateExecutionHeader validates the provided execution payload header
-// for the genesis block.
-func validateExecutionHeader(header *types.ExecutionPayloadHeader) error {
+// validateExecutionHeader validates the provided execution payload header.
+// It is either the header of the EL genesis block or, for a genesis exported
+// from the state of an existing chain, the latest header of that chain.
+func validateExecutionHeader(header *types.ExecutionPayloadHeader, exported bool) error {
 	if header == nil {
 		return errors.New("execution payload header cannot be nil")
 	}
 
-	// Check block number to be 0
-	if header.Number != 0 {
-		return errors.New("block number must be 0 for genesis block")
-	}
-
 	if header.GasLimit == 0 {
 		return errors.New("gas limit cannot be zero")
 	}
 
-	if header.GasUsed != 0 {
-		return errors.New("gas used must be zero for genesis block")
+	if header.GasUsed > header.GasLimit {
+		return fmt.Errorf("gas used (%d) exceeds gas limit (%d)",
+			header.GasUsed, header.GasLimit,
+		)
 	}
 
 	if header.BaseFeePerGas == nil {
 		return errors.New("base fee per gas cannot be nil")
 	}
 
+	if header.BlobGasUsed > header.GasLimit {
+		return fmt.Errorf("blob gas used (%d) exceeds gas limit (%d)",
+			header.BlobGasUsed, header.GasLimit,
+		)
+	}
+
+	// Validate hash fields are not zero
+	zeroHash := common.ExecutionHash{}
+	if bytes.Equal(header.BlockHash[:], zeroHash[:]) {
+		return errors.New("block hash cannot be zero")
+	}
+
+	// Fee recipient can be zero in genesis block
+	// No need to validate fee recipient for genesis
+
+	// We don't validate LogsBloom as it can legitimately be
+	// all zeros in a genesis block or in blocks with no logs
+
+	// Extra data length check (max 32 bytes)
+	if len(header.ExtraData) > maxExtraDataSize {
+		return fmt.Errorf(
+			"extra data too long: got %d bytes, max 32 bytes",
+			len(header.ExtraData),
+		)
+	}
+
+	// An exported state carries the latest header of its chain, which only
+	// has to descend from a parent block.
+	if exported {
+		if header.Number == 0 {
+			return errors.New("block number cannot be zero for exported state")
+		}
+		if bytes.Equal(header.ParentHash[:], zeroHash[:]) {
+			return errors.New("parent hash cannot be zero for exported state")
+		}
+		return nil
+	}
+	return validateGenesisBlockHeader(header)
+}
+
+// validateGenesisBlockHeader validates the fields of the provided execution
+// payload header which are fixed for the EL genesis block.
+func validateGenesisBlockHeader(header *types.ExecutionPayloadHeader) error {
+	if header.Number != 0 {
+		return errors.New("block number must be zero for genesis block")
+	}
+
+	if header.GasUsed != 0 {
+		return errors.New("gas used must be zero for genesis block")
+	}
+
 	// Additional Deneb-specific validations for blob gas
 	if header.BlobGasUsed != 0 {
 		return errors.New(
@@ -169,13 +263,6 @@ func validateExecutionHeader(header *types.ExecutionPayloadHeader) error {
 		)
 	}
 
-	if header.BlobGasUsed > header.GasLimit {
-		return fmt.Errorf("blob gas used (%d) exceeds gas limit (%d)",
-			header.BlobGasUsed, header.GasLimit,
-		)
-	}
-
-	// Validate hash fields are not zero
 	zeroHash := common.ExecutionHash{}
 	emptyTrieRoot := common.Bytes32(
 		common.NewExecutionHashFromHex(
@@ -193,30 +280,12 @@ func validateExecutionHeader(header *types.ExecutionPayloadHeader) error {
 		)
 	}
 
-	if bytes.Equal(header.BlockHash[:], zeroHash[:]) {
-		return errors.New("block hash cannot be zero")
-	}
-
 	// Validate prevRandao is zero for genesis
 	var zeroBytes32 common.Bytes32
 	if !bytes.Equal(header.Random[:], zeroBytes32[:]) {
 		return errors.New("prevRandao must be zero for genesis block")
 	}
 
-	// Fee recipient can be zero in genesis block
-	// No need to validate fee recipient for genesis
-
-	// We don't validate LogsBloom as it can legitimately be
-	// all zeros in a genesis block or in blocks with no logs
-
-	// Extra data length check (max 32 bytes)
-	if len(header.ExtraData) > maxExtraDataSize {
-		return fmt.Errorf(
-			"extra data too long: got %d bytes, max 32 bytes",
-			len(header.ExtraData),
-		)
-	}
-
 	return nil
 }
 
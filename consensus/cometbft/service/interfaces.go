@@ -21,7 +21,10 @@
 package cometbft
 
 import (
+	"context"
 	"time"
+
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -29,3 +32,9 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
 }
+
+// StorageBackend is the beacon storage backend answering ABCI queries.
+type StorageBackend interface {
+	// StateFromContext returns the beacon state over the given context.
+	StateFromContext(ctx context.Context) *statedb.StateDB
+}
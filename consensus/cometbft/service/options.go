@@ -24,7 +24,11 @@ import (
 	"fmt"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -83,3 +87,33 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Servic
 func SetChainID(chainID string) func(*Service) {
 	return func(s *Service) { s.chainID = chainID }
 }
+
+// SetSnapshot provides a Service option function that sets the state-sync
+// snapshot manager over the multistore, taking snapshots with the given options
+// and appending the given extensions to every snapshot. The manager is set even
+// if snapshots are disabled, so that a fresh node can restore from snapshots.
+func SetSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) func(*Service) {
+	return func(s *Service) {
+		cms := s.sm.GetCommitMultiStore()
+		cms.SetSnapshotInterval(opts.Interval)
+		s.snapshotManager = snapshots.NewManager(
+			snapshotStore, opts, cms, nil, servercmtlog.WrapSDKLogger(s.logger),
+		)
+		if err := s.snapshotManager.RegisterExtensions(extensions...); err != nil {
+			panic(fmt.Errorf("failed registering snapshot extensions: %w", err))
+		}
+	}
+}
+
+// SetStorageBackend provides a Service option function that sets the beacon
+// storage backend answering the ABCI queries.
+func SetStorageBackend(sb StorageBackend, cs chain.Spec) func(*Service) {
+	return func(s *Service) {
+		s.storageBackend = sb
+		s.chainSpec = cs
+	}
+}
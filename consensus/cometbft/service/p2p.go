@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// NodeInfo returns the p2p info of the node, which is nil until the node has
+// been started.
+func (s *Service) NodeInfo() p2p.NodeInfo {
+	if s.node == nil {
+		return nil
+	}
+	return s.node.NodeInfo()
+}
+
+// Peers returns the peers the node is connected to.
+func (s *Service) Peers() []p2p.Peer {
+	if s.node == nil {
+		return nil
+	}
+	return s.node.Switch().Peers().Copy()
+}
+
+// IsCatchingUp returns true while the node is syncing blocks from its peers
+// instead of participating in consensus.
+func (s *Service) IsCatchingUp() bool {
+	if s.node == nil {
+		return false
+	}
+	return s.node.ConsensusReactor().WaitSync()
+}
+
+// MaxPeerHeight returns the highest block height reported by the consensus
+// reactor of any connected peer.
+func (s *Service) MaxPeerHeight() int64 {
+	var height int64
+	for _, peer := range s.Peers() {
+		ps, ok := peer.Get(cmttypes.PeerStateKey).(interface{ GetHeight() int64 })
+		if ok && ps.GetHeight() > height {
+			height = ps.GetHeight()
+		}
+	}
+	return height
+}
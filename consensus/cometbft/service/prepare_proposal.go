@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/consensus/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -64,6 +65,8 @@ func (s *Service) prepareProposal(
 		nil,                        // no slashings
 		req.GetProposerAddress(),
 		req.GetTime(),
+		encoding.ExtractMisbehaviorsFromRequest(req),
+		encoding.ExtractVotes(req.GetLocalLastCommit().Votes),
 	)
 
 	//nolint:contextcheck // ctx already passed via resetState
@@ -56,6 +56,7 @@ func (s *Service) processProposal(
 	if req.Height > s.initialHeight {
 		s.finalizeBlockState = s.resetState(ctx)
 	}
+	s.acceptedProposalHash = nil
 
 	//nolint:contextcheck // ctx already passed via resetState
 	s.processProposalState.SetContext(
@@ -73,7 +74,15 @@ func (s *Service) processProposal(
 		s.processProposalState.Context(),
 		req,
 	)
-	if err != nil {
+	switch {
+	case err == nil && req.Height > s.initialHeight:
+		// The post-state of the accepted block is held by the ProcessProposal
+		// state, which can then be committed if the block is finalized. On
+		// the initial height ProcessProposal runs on a branch of the
+		// finalizeBlockState instead, so the block is executed again.
+		s.acceptedProposalHeight = req.Height
+		s.acceptedProposalHash = req.Hash
+	case err != nil:
 		status = cmtabci.PROCESS_PROPOSAL_STATUS_REJECT
 		s.logger.Error(
 			"failed to process proposal",
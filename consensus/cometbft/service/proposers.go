@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var errNodeNotStarted = errors.New("node is not started")
+
+// ProposerSchedule predicts the pubkeys of the proposers of count heights
+// from start on. The proposers of heights up to the next one are given by the
+// stored validator sets, those of later heights are extrapolated from the
+// validator set of the next height with CometBFT's weighted round-robin.
+//
+// NOTE: the schedule assumes that every height is decided in its first round
+// and that the validator set does not change. Round changes and validator set
+// updates shift the actual schedule.
+func (s *Service) ProposerSchedule(
+	start int64,
+	count int,
+) ([]crypto.BLSPubkey, error) {
+	stateStore := s.cmtStateStore
+	if stateStore == nil {
+		return nil, errNodeNotStarted
+	}
+
+	next := s.LastBlockHeight() + 1
+	proposers := make([]crypto.BLSPubkey, 0, count)
+	var (
+		vals *cmttypes.ValidatorSet
+		err  error
+	)
+	for height := start; len(proposers) < count; height++ {
+		if height <= next || vals == nil {
+			vals, err = stateStore.LoadValidators(min(height, next))
+			if err != nil {
+				return nil, fmt.Errorf(
+					"failed to load validators of height %d: %w", height, err,
+				)
+			}
+			if height > next {
+				//#nosec: G115 // schedules span a few epochs at most.
+				vals.IncrementProposerPriority(int32(height - next))
+			}
+		} else {
+			vals.IncrementProposerPriority(1)
+		}
+
+		pubkey := vals.GetProposer().PubKey.Bytes()
+		if len(pubkey) != len(crypto.BLSPubkey{}) {
+			return nil, fmt.Errorf(
+				"invalid proposer pubkey length %d at height %d",
+				len(pubkey), height,
+			)
+		}
+		proposers = append(proposers, crypto.BLSPubkey(pubkey))
+	}
+	return proposers, nil
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"strconv"
+	"strings"
+
+	sdkcollections "cosmossdk.io/collections"
+	cosmoserrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	errorsmod "github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Beacon query paths. Each path resolves to a single entry of the beacon
+// store, whose raw value is returned together with its IAVL proof against the
+// app hash if requested:
+//   - /beacon/validator/{pubkey}: SSZ encoded validator with the hex pubkey.
+//   - /beacon/balance/{index}: big-endian balance of the validator index.
+//   - /beacon/state_root: the latest state root recorded in the beacon state,
+//     i.e. the root of the state of the previous slot.
+//   - /beacon/deposit_root: SSZ encoded eth1 data holding the deposit root.
+const (
+	beaconQueryRoute      = "beacon"
+	validatorQueryPath    = "validator"
+	balanceQueryPath      = "balance"
+	stateRootQueryPath    = "state_root"
+	depositRootQueryPath  = "deposit_root"
+	storeKeyQuerySubpath  = "key"
+	queryPathSeparator    = "/"
+	validatorIndexBitSize = 64
+)
+
+func (s *Service) query(req *abci.QueryRequest) *abci.QueryResponse {
+	resp, err := s.handleQuery(req)
+	if err != nil {
+		space, code, log := cosmoserrors.ABCIInfo(err, false)
+		return &abci.QueryResponse{
+			Codespace: space,
+			Code:      code,
+			Log:       log,
+			Height:    req.Height,
+		}
+	}
+	return resp
+}
+
+func (s *Service) handleQuery(
+	req *abci.QueryRequest,
+) (*abci.QueryResponse, error) {
+	if s.storageBackend == nil {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrUnknownRequest, "beacon queries are not enabled",
+		)
+	}
+
+	path := strings.Split(strings.Trim(req.Path, queryPathSeparator), queryPathSeparator)
+	if len(path) < 2 || path[0] != beaconQueryRoute {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnknownRequest, "unknown query path %s", req.Path,
+		)
+	}
+
+	// Resolve the height now, so that the key and its value are read from
+	// the same version of the store.
+	height := req.Height
+	if height == 0 {
+		height = s.LastBlockHeight()
+	}
+	queryCtx, err := s.CreateQueryContext(height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.beaconStoreKey(s.storageBackend.StateFromContext(queryCtx), path[1:])
+	if err != nil {
+		return nil, err
+	}
+	return s.queryStore(key, height, req.Prove)
+}
+
+// beaconStoreKey returns the beacon store key answering the query path.
+func (s *Service) beaconStoreKey(st *statedb.StateDB, path []string) ([]byte, error) {
+	switch {
+	case path[0] == validatorQueryPath && len(path) == 2:
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(path[1])); err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid validator pubkey %s: %v", path[1], err,
+			)
+		}
+		idx, err := st.ValidatorIndexByPubkey(pubkey)
+		if errorsmod.Is(err, sdkcollections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrNotFound, "validator %s", pubkey,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return beacondb.ValidatorStoreKey(idx)
+
+	case path[0] == balanceQueryPath && len(path) == 2:
+		idx, err := strconv.ParseUint(path[1], 10, validatorIndexBitSize)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid validator index %s: %v", path[1], err,
+			)
+		}
+		return beacondb.BalanceStoreKey(math.ValidatorIndex(idx))
+
+	case path[0] == stateRootQueryPath && len(path) == 1:
+		slot, err := st.GetSlot()
+		if err != nil {
+			return nil, err
+		}
+		if slot == 0 {
+			return nil, errorsmod.Wrap(
+				sdkerrors.ErrNotFound, "no state root recorded at genesis",
+			)
+		}
+		return beacondb.StateRootStoreKey(
+			(slot.Unwrap() - 1) % s.chainSpec.SlotsPerHistoricalRoot(),
+		)
+
+	case path[0] == depositRootQueryPath && len(path) == 1:
+		return beacondb.Eth1DataStoreKey(), nil
+
+	default:
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnknownRequest,
+			"unknown query path /%s/%s", beaconQueryRoute, strings.Join(path, queryPathSeparator),
+		)
+	}
+}
+
+// queryStore reads the value of the key from the beacon store at the given
+// height. If prove is set, the response carries the IAVL proof of the value
+// and the proof of the beacon store against the app hash.
+func (s *Service) queryStore(
+	key []byte, height int64, prove bool,
+) (*abci.QueryResponse, error) {
+	queryable, ok := s.sm.GetCommitMultiStore().(storetypes.Queryable)
+	if !ok {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrUnknownRequest, "multistore does not support queries",
+		)
+	}
+
+	res, err := queryable.Query(&storetypes.RequestQuery{
+		Path: queryPathSeparator + storage.StoreKey.Name() +
+			queryPathSeparator + storeKeyQuerySubpath,
+		Data:   key,
+		Height: height,
+		Prove:  prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &abci.QueryResponse{
+		Key:       res.Key,
+		Value:     res.Value,
+		ProofOps:  res.ProofOps,
+		Height:    res.Height,
+		Codespace: res.Codespace,
+	}, nil
+}
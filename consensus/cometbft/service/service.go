@@ -25,9 +25,11 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
 	statem "github.com/berachain/beacon-kit/consensus/cometbft/service/state"
 	errorsmod "github.com/berachain/beacon-kit/errors"
@@ -41,6 +43,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +58,10 @@ const (
 type Service struct {
 	node *node.Node
 
+	// cmtStateStore is the state store of the node, set once the node has
+	// been created.
+	cmtStateStore sm.Store
+
 	// cmtConsensusParams are part of the blockchain state and
 	// are agreed upon by all validators in the network.
 	cmtConsensusParams *cmttypes.ConsensusParams
@@ -78,11 +85,17 @@ type Service struct {
 	prepareProposalState *state
 
 	// processProposalState is used for ProcessProposal, which is set based on
-	// the previous block's state. This state is never committed. In case of
-	// multiple consensus rounds, the state is always reset to the previous
-	// block's state.
+	// the previous block's state. In case of multiple consensus rounds, the
+	// state is always reset to the previous block's state. It is only
+	// committed when it holds the post-state of the block being finalized.
 	processProposalState *state
 
+	// acceptedProposalHeight and acceptedProposalHash identify the block
+	// accepted by the last ProcessProposal, whose post-state is held by
+	// processProposalState. The hash is nil if no block was accepted.
+	acceptedProposalHeight int64
+	acceptedProposalHash   []byte
+
 	// finalizeBlockState is used for FinalizeBlock, which is set based on the
 	// previous block's state. This state is committed. finalizeBlockState is
 	// set
@@ -91,6 +104,15 @@ type Service struct {
 
 	interBlockCache storetypes.MultiStorePersistentCache
 
+	// storageBackend and chainSpec answer the beacon ABCI queries. Queries
+	// are rejected if they are not configured.
+	storageBackend StorageBackend
+	chainSpec      chain.Spec
+
+	// snapshotManager takes the state-sync snapshots and serves or restores
+	// them through the ABCI snapshot methods. It is nil if not configured.
+	snapshotManager *snapshots.Manager
+
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
@@ -191,6 +213,11 @@ func (s *Service) Start(
 	if err != nil {
 		return err
 	}
+	rpcEnv, err := s.node.ConfigureRPC()
+	if err != nil {
+		return err
+	}
+	s.cmtStateStore = rpcEnv.StateStore
 
 	started := make(chan struct{})
 
@@ -225,6 +252,13 @@ func (s *Service) Stop() error {
 		s.node.Wait()
 	}
 
+	if s.snapshotManager != nil {
+		s.logger.Info("Closing snapshot database")
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close snapshot database: %w", err))
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close application.id: %w", err))
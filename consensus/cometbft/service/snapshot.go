@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+)
+
+func (s *Service) listSnapshots() (*abci.ListSnapshotsResponse, error) {
+	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshots, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "err", err)
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		abciSnapshot, cErr := snapshot.ToABCI()
+		if cErr != nil {
+			s.logger.Error("Failed to convert ABCI snapshot", "err", cErr)
+			return nil, cErr
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+	return resp, nil
+}
+
+func (s *Service) loadSnapshotChunk(
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height, "format", req.Format, "chunk", req.Chunk, "err", err,
+		)
+		return nil, err
+	}
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+// offerSnapshot starts restoring the offered snapshot. The IAVL multistore and
+// the snapshot extensions are only restored on a fresh node, so that they are
+// all restored at the same height.
+func (s *Service) offerSnapshot(
+	req *abci.OfferSnapshotRequest,
+) (*abci.OfferSnapshotResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+	if lastHeight := s.sm.GetCommitMultiStore().LastCommitID().Version; lastHeight != 0 {
+		s.logger.Error("Cannot restore snapshot on a node with state", "last_height", lastHeight)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "err", err)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT}, nil
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", req.Snapshot.Height, "format", req.Snapshot.Format, "err", err,
+		)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	default:
+		// Stores cannot be reset to retry a different snapshot, so we ask
+		// CometBFT to abort the state sync altogether.
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", req.Snapshot.Height, "format", req.Snapshot.Format, "err", err,
+		)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+}
+
+func (s *Service) applySnapshotChunk(
+	req *abci.ApplySnapshotChunkRequest,
+) (*abci.ApplySnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}, nil
+	}
+
+	_, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil:
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Chunk checksum mismatch, rejecting sender and requesting refetch",
+			"chunk", req.Index, "sender", req.Sender, "err", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}, nil
+	default:
+		s.logger.Error("Failed to restore snapshot", "err", err)
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}, nil
+	}
+}
@@ -20,7 +20,10 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/primitives/math"
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+)
 
 type commonConsensusData struct {
 	// use to verify block builder
@@ -28,6 +31,12 @@ type commonConsensusData struct {
 
 	// used to build next block and validate current payload timestamp
 	consensusTime math.U64
+
+	// misbehaviors committed by validators, as reported by consensus
+	misbehaviors []transition.Misbehavior
+
+	// votes of the validators on the previous block, used to track liveness
+	lastCommitVotes []transition.Vote
 }
 
 // GetProposerAddress returns the address of the validator
@@ -41,3 +50,15 @@ func (c *commonConsensusData) GetProposerAddress() []byte {
 func (c *commonConsensusData) GetConsensusTime() math.U64 {
 	return c.consensusTime
 }
+
+// GetMisbehaviors returns the validator misbehaviors reported by consensus,
+// which must be slashed when processing the block.
+func (c *commonConsensusData) GetMisbehaviors() []transition.Misbehavior {
+	return c.misbehaviors
+}
+
+// GetLastCommitVotes returns the votes of the validators on the previous
+// block, as recorded by consensus.
+func (c *commonConsensusData) GetLastCommitVotes() []transition.Vote {
+	return c.lastCommitVotes
+}
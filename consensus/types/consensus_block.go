@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 type ConsensusBlock struct {
@@ -39,12 +40,16 @@ func NewConsensusBlock(
 	beaconBlock *types.BeaconBlock,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
 ) *ConsensusBlock {
 	return &ConsensusBlock{
 		blk: beaconBlock,
 		commonConsensusData: &commonConsensusData{
 			proposerAddress: proposerAddress,
 			consensusTime:   math.U64(consensusTime.Unix()), // #nosec G115
+			misbehaviors:    misbehaviors,
+			lastCommitVotes: lastCommitVotes,
 		},
 	}
 }
@@ -25,6 +25,7 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 // SlotData represents the data to be used to propose a block.
@@ -47,6 +48,8 @@ func NewSlotData(
 	slashingInfo []*ctypes.SlashingInfo,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	misbehaviors []transition.Misbehavior,
+	lastCommitVotes []transition.Vote,
 ) *SlotData {
 	return &SlotData{
 		slot:            slot,
@@ -55,6 +58,8 @@ func NewSlotData(
 		commonConsensusData: &commonConsensusData{
 			proposerAddress: proposerAddress,
 			consensusTime:   math.U64(consensusTime.Unix()), // #nosec G115
+			misbehaviors:    misbehaviors,
+			lastCommitVotes: lastCommitVotes,
 		},
 	}
 }
@@ -29,6 +29,10 @@ type IndexDB interface {
 	// Prune returns error if start > end.
 	Prune(start uint64, end uint64) error
 
+	// Truncate removes all entries with an index greater than or equal to
+	// from.
+	Truncate(from uint64) error
+
 	// GetByIndex takes the database index and returns all associated entries,
 	// expecting database keys to follow the prefix() format. If index does not
 	// exist in the DB for any reason (pruned, invalid index), an empty list is
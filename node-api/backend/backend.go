@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -39,6 +40,7 @@ import (
 type Backend struct {
 	sb   *storage.Backend
 	cs   chain.Spec
+	ec   *client.EngineClient
 	node types.ConsensusService
 
 	// genesisValidatorsRoot is cached in the backend.
@@ -56,10 +58,12 @@ func New(
 	storageBackend *storage.Backend,
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
+	engineClient *client.EngineClient,
 ) (*Backend, error) {
 	b := &Backend{
 		sb: storageBackend,
 		cs: cs,
+		ec: engineClient,
 	}
 
 	// Load the genesis file from cometbft config.
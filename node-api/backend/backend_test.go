@@ -29,8 +29,10 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/cometbft/cometbft/p2p"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -76,3 +78,23 @@ func (t *testConsensusService) Name() string {
 func (t *testConsensusService) LastBlockHeight() int64 {
 	panic(errTestMemberNotImplemented)
 }
+
+func (t *testConsensusService) NodeInfo() p2p.NodeInfo {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) Peers() []p2p.Peer {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) IsCatchingUp() bool {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) MaxPeerHeight() int64 {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) ProposerSchedule(int64, int) ([]crypto.BLSPubkey, error) {
+	panic(errTestMemberNotImplemented)
+}
@@ -24,14 +24,14 @@ import (
 	"errors"
 	"fmt"
 
-	apitypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
 // BlobSidecarsByIndices is the backend helper function that will query the
 // data availability store for all sidecars for a slot, returning only those
 // sidecars specified by the indices, or all sidecars if left unspecified.
-func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*apitypes.Sidecar, error) {
+func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) (datypes.BlobSidecars, error) {
 	currentSlot := b.node.LastBlockHeight()
 	if currentSlot < 0 {
 		return nil, errors.New("invalid negative block height")
@@ -71,23 +71,20 @@ func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*ap
 		isRequestIndex[idx] = true
 	}
 
-	// Preallocate response slice - if indices specified, size will be len(indices),
+	// Preallocate result slice - if indices specified, size will be len(indices),
 	// otherwise size will be all sidecars.
-	responseCap := len(blobSidecars)
+	resultCap := len(blobSidecars)
 	if len(indices) > 0 {
-		responseCap = len(indices)
+		resultCap = len(indices)
 	}
-	blobSidecarsResponse := make([]*apitypes.Sidecar, 0, responseCap)
+	result := make(datypes.BlobSidecars, 0, resultCap)
 
 	for _, blobSidecar := range blobSidecars {
 		// Skip if indices specified and this index not requested.
 		if len(indices) > 0 && !isRequestIndex[blobSidecar.GetIndex()] {
 			continue
 		}
-		// Craft and append the blob sidecar serialized data to the response.
-		blobSidecarsResponse = append(blobSidecarsResponse,
-			apitypes.SidecarFromConsensus(blobSidecar),
-		)
+		result = append(result, blobSidecar)
 	}
-	return blobSidecarsResponse, nil
+	return result, nil
 }
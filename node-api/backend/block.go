@@ -21,11 +21,17 @@
 package backend
 
 import (
+	"context"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/block"
 )
 
 // BlockHeaderAtSlot returns the block header at the given slot.
@@ -45,6 +51,54 @@ func (b *Backend) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader,
 	return blockHeader, nil
 }
 
+// SignedBlockHeaderAtSlot returns the block header at the given slot along
+// with the proposer signature of the block. The genesis block is unsigned, so
+// it is returned with the empty signature.
+func (b *Backend) SignedBlockHeaderAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlockHeader, error) {
+	blockHeader, err := b.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if blockHeader.GetSlot() == constants.GenesisSlot {
+		return ctypes.NewSignedBeaconBlockHeader(blockHeader, crypto.BLSSignature{}), nil
+	}
+
+	signature, err := b.sb.SignedBlockStore().GetSignature(
+		context.Background(), blockHeader.GetSlot(),
+	)
+	if errors.Is(err, block.ErrSignedBlockNotFound) {
+		return nil, errors.Wrap(handlertypes.ErrNotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewSignedBeaconBlockHeader(blockHeader, signature), nil
+}
+
+// SignedBlockAtSlot returns the full signed beacon block at the given slot,
+// resolving an input slot of 0 to the latest stored block.
+func (b *Backend) SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error) {
+	blk, err := b.sb.SignedBlockStore().Get(context.Background(), slot)
+	if errors.Is(err, block.ErrSignedBlockNotFound) {
+		return nil, errors.Wrap(handlertypes.ErrNotFound, err.Error())
+	}
+	return blk, err
+}
+
+// ForkVersionAtSlot returns the fork version of the beacon state at the given
+// slot.
+func (b *Backend) ForkVersionAtSlot(slot math.Slot) (common.Version, error) {
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return common.Version{}, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	fork, err := st.GetFork()
+	if err != nil {
+		return common.Version{}, errors.Wrapf(err, "failed to get fork")
+	}
+	return fork.CurrentVersion, nil
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, _, err := b.StateAtSlot(slot)
@@ -63,14 +117,18 @@ func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	return blockHeader.HashTreeRoot(), nil
 }
 
-// TODO: Implement this.
-func (b *Backend) BlockRewardsAtSlot(_ math.Slot) (*types.BlockRewardsData, error) {
+// BlockRewardsAtSlot returns the consensus layer rewards of the proposer of
+// the block at the given slot. Proposers are only rewarded the fixed per block
+// reward of the chain spec, as beacon-kit has no attestations, sync committees
+// nor whistleblower rewards for slashings.
+func (b *Backend) BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error) {
+	signedBlk, err := b.SignedBlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	blk := signedBlk.GetBeaconBlock()
 	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
+		ProposerIndex: blk.GetProposerIndex().Unwrap(),
+		Total:         b.cs.ProposerRewardPerBlock(blk.GetTimestamp()),
 	}, nil
 }
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ProposerDuties returns the proposers of the slots of the given epoch, as
+// predicted by the consensus engine. Only the current and the next epoch can
+// be predicted. Validator indices are resolved from the head state.
+func (b *Backend) ProposerDuties(epoch math.Epoch) ([]*validatortypes.ProposerDutyData, error) {
+	head := b.node.LastBlockHeight()
+	if head < 0 {
+		return nil, errors.New("invalid negative block height")
+	}
+	currentEpoch := b.cs.SlotToEpoch(math.Slot(head))
+	if epoch < currentEpoch || epoch > currentEpoch+1 {
+		return nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"proposer duties are only available for epochs %d and %d",
+			currentEpoch, currentEpoch+1,
+		)
+	}
+
+	// The genesis slot has no proposer.
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	start := max(epoch.Unwrap()*slotsPerEpoch, 1)
+	end := (epoch.Unwrap() + 1) * slotsPerEpoch
+
+	//#nosec: G115 // slots are heights, which fit in an int64.
+	pubkeys, err := b.node.ProposerSchedule(int64(start), int(end-start))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to predict proposers of epoch %d", epoch)
+	}
+
+	st, _, err := b.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head state")
+	}
+	duties := make([]*validatortypes.ProposerDutyData, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		index, idxErr := st.ValidatorIndexByPubkey(pubkey)
+		if idxErr != nil {
+			return nil, errors.Wrapf(idxErr, "failed to get index of proposer %s", pubkey)
+		}
+		duties[i] = &validatortypes.ProposerDutyData{
+			Pubkey:         pubkey.String(),
+			ValidatorIndex: index.Base10(),
+			Slot:           math.Slot(start + uint64(i)).Base10(),
+		}
+	}
+	return duties, nil
+}
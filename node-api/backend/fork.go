@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ForkSchedule returns the forks of the chain spec fork registry, starting
+// with the genesis fork.
+//
+// Forks activate on timestamps in beacon-kit, so the epoch of a fork is only
+// known once the fork is recorded in the head state. Forks that are not yet
+// active are reported at the far future epoch. The beacon state only records
+// the epoch of its latest fork, so earlier forks are reported at that epoch too.
+// Deneb1 never updates the Fork of the beacon state, hence it does not appear
+// in the schedule.
+func (b *Backend) ForkSchedule() ([]*ctypes.Fork, error) {
+	genesisVersion, err := b.GenesisForkVersion()
+	if err != nil {
+		return nil, err
+	}
+	schedule := []*ctypes.Fork{
+		ctypes.NewFork(genesisVersion, genesisVersion, constants.GenesisEpoch),
+	}
+
+	var headFork *ctypes.Fork
+	previousVersion := genesisVersion
+	for _, fork := range b.cs.Forks() {
+		if !version.IsAfter(fork.Version, genesisVersion) || version.Equals(fork.Version, version.Deneb1()) {
+			continue
+		}
+
+		// Only load the head state if a fork follows the genesis fork.
+		if headFork == nil {
+			st, _, sErr := b.StateAtSlot(0)
+			if sErr != nil {
+				return nil, errors.Wrapf(sErr, "failed to get head state")
+			}
+			if headFork, err = st.GetFork(); err != nil {
+				return nil, errors.Wrapf(err, "failed to get fork")
+			}
+		}
+		forkEpoch := math.Epoch(constants.FarFutureEpoch)
+		if version.IsBeforeOrEquals(fork.Version, headFork.CurrentVersion) {
+			forkEpoch = headFork.Epoch
+		}
+		schedule = append(schedule, ctypes.NewFork(previousVersion, fork.Version, forkEpoch))
+		previousVersion = fork.Version
+	}
+	return schedule, nil
+}
+
+// FinalityCheckpointsAtSlot returns the finality checkpoints of the state at
+// the given slot. CometBFT provides single slot finality, so the checkpoint of
+// the state's own epoch is justified and finalized as soon as it is committed.
+func (b *Backend) FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error) {
+	st, slot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+
+	// The checkpoint root is the root of the block at the start of the epoch,
+	// which is the latest block if the state is at the start of the epoch.
+	epoch := b.cs.SlotToEpoch(slot)
+	epochStartSlot := epoch.Unwrap() * b.cs.SlotsPerEpoch()
+	var checkpoint *types.Checkpoint
+	if epochStartSlot == slot.Unwrap() {
+		blockHeader, hErr := st.GetLatestBlockHeader()
+		if hErr != nil {
+			return nil, errors.Wrapf(hErr, "failed to get latest block header")
+		}
+		blockHeader.SetStateRoot(st.HashTreeRoot())
+		checkpoint = &types.Checkpoint{Epoch: epoch.Base10(), Root: blockHeader.HashTreeRoot()}
+	} else {
+		root, rErr := st.GetBlockRootAtIndex(epochStartSlot % b.cs.SlotsPerHistoricalRoot())
+		if rErr != nil {
+			return nil, errors.Wrapf(rErr, "failed to get block root at slot %d", epochStartSlot)
+		}
+		checkpoint = &types.Checkpoint{Epoch: epoch.Base10(), Root: root}
+	}
+
+	return &types.FinalityCheckpointsData{
+		PreviousJustified: checkpoint,
+		CurrentJustified:  checkpoint,
+		Finalized:         checkpoint,
+	}, nil
+}
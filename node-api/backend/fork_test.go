@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkScheduleAndFinalityCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	// Build backend to test
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(cs, nil, kvStore, depositStore, nil, nil, nil)
+
+	// Create CometBFT config and a Deneb genesis in a temporary directory.
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	appGenesis.GenesisTime = time.Unix(1737410400, 0)
+	require.NoError(t, appGenesis.SaveAs(filepath.Join(tmpDir, "config", "genesis.json")))
+
+	b, err := backend.New(sb, cs, cmtCfg, nil)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{
+		cms:     cms,
+		kvStore: kvStore,
+		cs:      cs,
+	})
+
+	// Set up a head state a few slots into an epoch, before Electra.
+	epoch := math.Epoch(3)
+	epochStartSlot := math.Slot(epoch.Unwrap() * cs.SlotsPerEpoch())
+	epochStartRoot := common.Root{0xaa}
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(kvStore.WithContext(sdkCtx), cs)
+	setupStateDummyParts(t, cs, st, epochStartSlot+2)
+	require.NoError(t, st.UpdateBlockRootAtIndex(
+		epochStartSlot.Unwrap()%cs.SlotsPerHistoricalRoot(), epochStartRoot,
+	))
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+	schedule, err := b.ForkSchedule()
+	require.NoError(t, err)
+	require.Equal(t, []*ctypes.Fork{
+		ctypes.NewFork(version.Deneb(), version.Deneb(), constants.GenesisEpoch),
+		ctypes.NewFork(version.Deneb(), version.Electra(), math.Epoch(constants.FarFutureEpoch)),
+	}, schedule)
+
+	checkpoints, err := b.FinalityCheckpointsAtSlot(0)
+	require.NoError(t, err)
+	require.Equal(t, epoch.Base10(), checkpoints.Finalized.Epoch)
+	require.Equal(t, epochStartRoot, checkpoints.Finalized.Root)
+	require.Equal(t, checkpoints.Finalized, checkpoints.CurrentJustified)
+	require.Equal(t, checkpoints.Finalized, checkpoints.PreviousJustified)
+
+	// Once Electra is recorded in the head state, its epoch is scheduled.
+	sdkCtx = sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st = statedb.NewBeaconStateFromDB(kvStore.WithContext(sdkCtx), cs)
+	require.NoError(t, st.SetFork(ctypes.NewFork(version.Deneb(), version.Electra(), epoch)))
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+	schedule, err = b.ForkSchedule()
+	require.NoError(t, err)
+	require.Len(t, schedule, 2)
+	require.Equal(t, epoch, schedule[1].Epoch)
+}
@@ -69,7 +69,7 @@ func TestGetGenesisData(t *testing.T) {
 
 	// Setup state for genesis tests.
 	setupStateWithGenesisValues(t, cms, kvStore)
-	sb := storage.NewBackend(cs, nil, kvStore, depositStore, nil)
+	sb := storage.NewBackend(cs, nil, kvStore, depositStore, nil, nil, nil)
 
 	// Create a temporary directory for CometBFT config
 	tmpDir := t.TempDir()
@@ -98,7 +98,7 @@ func TestGetGenesisData(t *testing.T) {
 	err = appGenesis.SaveAs(genesisFile)
 	require.NoError(t, err)
 
-	b, err := backend.New(sb, cs, cmtCfg)
+	b, err := backend.New(sb, cs, cmtCfg, nil)
 	require.NoError(t, err)
 	tcs := &testConsensusService{
 		cms:     cms,
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ValidatorsLiveness returns whether the given validators were live in the
+// given epoch, based on the missed block signatures tracked in the head state.
+// A validator is live if it was active and signed at least one of the blocks
+// of the epoch. Only epochs within the liveness window are available.
+func (b *Backend) ValidatorsLiveness(
+	epoch math.Epoch, indices []math.ValidatorIndex,
+) ([]*validatortypes.ValidatorLivenessData, error) {
+	st, slot, err := b.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head state")
+	}
+	currentEpoch := b.cs.SlotToEpoch(slot)
+	windowEpochs := b.cs.LivenessWindowEpochs()
+	if epoch > currentEpoch || currentEpoch-epoch >= math.Epoch(windowEpochs) {
+		return nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"liveness is only available for epochs %d to %d",
+			currentEpoch-min(currentEpoch, math.Epoch(windowEpochs-1)), currentEpoch,
+		)
+	}
+
+	// Only the blocks up to the head are known for the current epoch.
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	blocks := slotsPerEpoch
+	if epoch == currentEpoch {
+		blocks = slot.Unwrap()%slotsPerEpoch + 1
+	}
+
+	liveness := make([]*validatortypes.ValidatorLivenessData, len(indices))
+	for i, index := range indices {
+		val, valErr := st.ValidatorByIndex(index)
+		if errors.Is(valErr, collections.ErrNotFound) {
+			return nil, errors.Wrapf(
+				handlertypes.ErrInvalidRequest, "unknown validator index %d", index,
+			)
+		} else if valErr != nil {
+			return nil, errors.Wrapf(valErr, "failed to get validator %d", index)
+		}
+		missed, missedErr := st.GetMissedSignatures(epoch.Unwrap()%windowEpochs, index)
+		if missedErr != nil {
+			return nil, errors.Wrapf(missedErr, "failed to get missed signatures of validator %d", index)
+		}
+		liveness[i] = &validatortypes.ValidatorLivenessData{
+			Index:  index.Base10(),
+			IsLive: val.IsActive(epoch) && missed < blocks,
+		}
+	}
+	return liveness, nil
+}
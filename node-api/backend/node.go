@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+)
+
+// ErrNodeNotStarted is returned when the p2p info of the CometBFT node is
+// requested before the node has been started.
+var ErrNodeNotStarted = errors.New("node is not started")
+
+// NodeIdentity returns the p2p identity of the CometBFT node.
+func (b *Backend) NodeIdentity() (*nodetypes.IdentityData, error) {
+	info := b.node.NodeInfo()
+	if info == nil {
+		return nil, ErrNodeNotStarted
+	}
+	addr, err := info.NetAddress()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node address")
+	}
+	return &nodetypes.IdentityData{
+		PeerID:             string(info.ID()),
+		P2PAddresses:       []string{addr.String()},
+		DiscoveryAddresses: []string{},
+		// CometBFT has no attestation or sync committee subnets.
+		Metadata: nodetypes.MetadataData{
+			SeqNumber: "0",
+			Attnets:   "0x0000000000000000",
+			Syncnets:  "0x00",
+		},
+	}, nil
+}
+
+// NodePeers returns the peers the CometBFT node is connected to.
+func (b *Backend) NodePeers() []*nodetypes.PeerData {
+	peers := b.node.Peers()
+	data := make([]*nodetypes.PeerData, 0, len(peers))
+	for _, peer := range peers {
+		direction := nodetypes.DirectionInbound
+		if peer.IsOutbound() {
+			direction = nodetypes.DirectionOutbound
+		}
+		var lastSeen string
+		if addr := peer.SocketAddr(); addr != nil {
+			lastSeen = addr.String()
+		}
+		data = append(data, &nodetypes.PeerData{
+			PeerID:             string(peer.ID()),
+			LastSeenP2PAddress: lastSeen,
+			State:              nodetypes.PeerStateConnected,
+			Direction:          direction,
+		})
+	}
+	return data
+}
+
+// SyncingStatus returns the sync status of the CometBFT node and whether the
+// execution client is offline.
+func (b *Backend) SyncingStatus() (*nodetypes.SyncingData, error) {
+	if b.node.NodeInfo() == nil {
+		return nil, ErrNodeNotStarted
+	}
+	head := b.node.LastBlockHeight()
+	return &nodetypes.SyncingData{
+		HeadSlot:     strconv.FormatInt(head, 10),
+		SyncDistance: strconv.FormatInt(max(b.node.MaxPeerHeight()-head, 0), 10),
+		IsSyncing:    b.node.IsCatchingUp(),
+		// Never optimistic since we only return finalized data
+		IsOptimistic: false,
+		ELOffline:    !b.ec.IsConnected(),
+	}, nil
+}
+
+// NodeVersion returns the version of the node, formatted as in the Beacon API
+// spec.
+func (*Backend) NodeVersion() string {
+	return fmt.Sprintf(
+		"beacon-kit/%s/%s-%s", sdkversion.Version, runtime.GOARCH, runtime.GOOS,
+	)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// VoluntaryExits returns the signed voluntary exits pending in the pool.
+func (b *Backend) VoluntaryExits() []*ctypes.SignedVoluntaryExit {
+	return b.sb.VoluntaryExitPool().Pending()
+}
+
+// SubmitVoluntaryExit adds a signed voluntary exit to the pool, to be included
+// by this node in its next proposed block. Only the validator is checked
+// against the head state here; exits are fully verified, signature included,
+// when the block is built and invalid ones are dropped from the pool.
+func (b *Backend) SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error {
+	st, _, err := b.StateAtSlot(utils.Head)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get head state")
+	}
+	index := exit.Message.ValidatorIndex
+	val, err := st.ValidatorByIndex(index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errors.Wrapf(handlertypes.ErrInvalidRequest, "unknown validator index %d", index)
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to get validator %d", index)
+	}
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return errors.Wrapf(handlertypes.ErrInvalidRequest, "validator %d is already exiting", index)
+	}
+	return b.sb.VoluntaryExitPool().Add(exit)
+}
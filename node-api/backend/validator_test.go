@@ -57,7 +57,7 @@ func TestFilteredValidators(t *testing.T) {
 	require.NoError(t, err)
 	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
 	require.NoError(t, err)
-	sb := storage.NewBackend(cs, nil, kvStore, depositStore, nil)
+	sb := storage.NewBackend(cs, nil, kvStore, depositStore, nil, nil, nil)
 
 	// Create a temporary directory for CometBFT config
 	tmpDir := t.TempDir()
@@ -79,7 +79,7 @@ func TestFilteredValidators(t *testing.T) {
 	err = appGenesis.SaveAs(genesisFile)
 	require.NoError(t, err)
 
-	b, err := backend.New(sb, cs, cmtCfg)
+	b, err := backend.New(sb, cs, cmtCfg, nil)
 	require.NoError(t, err)
 	tcs := &testConsensusService{
 		cms:     cms,
@@ -21,21 +21,22 @@
 package blockstore
 
 const (
-	DefaultAvailabilityWindow = 8192
+	DefaultBlockRetention = 8192
 )
 
 // Config is the configuration for the block service.
 type Config struct {
 	// Enabled enables the block service.
 	Enabled bool `mapstructure:"enabled"`
-	// AvailabilityWindow is the number of slots to keep in the store.
-	AvailabilityWindow int `mapstructure:"availability-window"`
+	// BlockRetention is the number of most recent slots for which the full
+	// signed beacon blocks are kept on disk. 0 keeps every block.
+	BlockRetention uint64 `mapstructure:"block-retention"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:            false,
-		AvailabilityWindow: DefaultAvailabilityWindow,
+		Enabled:        false,
+		BlockRetention: DefaultBlockRetention,
 	}
 }
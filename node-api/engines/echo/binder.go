@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"io"
+	"strings"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/labstack/echo/v4"
+)
+
+// Binder binds requests with echo's default binder, except for SSZ encoded
+// bodies of requests implementing handlers.SSZRequest, which are decoded
+// for the fork given in the Eth-Consensus-Version header.
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind binds the path parameters and body of the request to i.
+func (b *Binder) Bind(i any, c echo.Context) error {
+	req, ok := i.(handlers.SSZRequest)
+	if !ok || !sendsSSZ(c) {
+		return b.DefaultBinder.Bind(i, c)
+	}
+	if err := b.BindPathParams(c, i); err != nil {
+		return err
+	}
+	bz, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return err
+	}
+	return req.UnmarshalSSZBody(
+		bz, c.Request().Header.Get(handlers.HeaderConsensusVersion),
+	)
+}
+
+// sendsSSZ returns true if the request body is SSZ encoded.
+func sendsSSZ(c echo.Context) bool {
+	return strings.HasPrefix(
+		c.Request().Header.Get(echo.HeaderContentType), mimeSSZ,
+	)
+}
@@ -49,6 +49,7 @@ func NewDefaultEngine() *Engine {
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
+	engine.Binder = &Binder{}
 	engine.HideBanner = true
 	return New(engine)
 }
@@ -22,6 +22,7 @@ package echo
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers"
@@ -35,16 +36,45 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// mimeSSZ is the content type of SSZ encoded responses.
+const mimeSSZ = "application/octet-stream"
+
 // responseMiddleware is a middleware that converts errors to an HTTP status
-// code and response.
+// code and response. Handlers returning a stream are responded to with
+// Server-Sent Events instead, handlers returning a status with the bare status
+// code, and SSZ encodable responses are SSZ encoded if the client accepts it.
+// The fork name of versioned responses is set in the Eth-Consensus-Version
+// header.
 func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 	return func(c handlers.Context) error {
 		data, err := handler.Handler(c)
+		if stream, ok := data.(handlers.Stream); ok && err == nil {
+			return streamResponse(c, stream)
+		}
+		if status, ok := data.(handlers.Status); ok && err == nil {
+			return c.NoContent(int(status))
+		}
+		if versioned, ok := data.(handlers.VersionedResponse); ok && err == nil {
+			c.Response().Header().Set(
+				handlers.HeaderConsensusVersion, versioned.ConsensusVersion(),
+			)
+		}
+		if sszData, ok := data.(handlers.SSZResponse); ok && err == nil && acceptsSSZ(c) {
+			var bz []byte
+			if bz, err = sszData.MarshalSSZ(); err == nil {
+				return c.Blob(http.StatusOK, mimeSSZ, bz)
+			}
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
 }
 
+// acceptsSSZ returns true if the client accepts SSZ encoded responses.
+func acceptsSSZ(c handlers.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), mimeSSZ)
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type sszRequest struct {
+	ID       string `param:"id"`
+	Body     []byte
+	ForkName string
+}
+
+func (r *sszRequest) UnmarshalSSZBody(bz []byte, forkName string) error {
+	r.Body, r.ForkName = bz, forkName
+	return nil
+}
+
+func TestResponseMiddlewareSSZ(t *testing.T) {
+	t.Parallel()
+	fork := ctypes.NewFork(common.Version{}, common.Version{4, 0, 0, 0}, 0)
+	route := &handlers.Route{
+		Handler: func(handlers.Context) (any, error) {
+			return beacontypes.NewSSZResponse(
+				"deneb", beacontypes.NewResponse("fork"), fork,
+			), nil
+		},
+	}
+	e := NewDefaultEngine()
+	e.GET("/fork", responseMiddleware(route))
+
+	// Responses are JSON encoded by default.
+	req := httptest.NewRequest(http.MethodGet, "/fork", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "deneb", rec.Header().Get(handlers.HeaderConsensusVersion))
+	require.JSONEq(t,
+		`{"execution_optimistic":false,"finalized":true,"data":"fork"}`,
+		rec.Body.String(),
+	)
+
+	// Clients accepting SSZ get the SSZ encoding of the data.
+	req = httptest.NewRequest(http.MethodGet, "/fork", nil)
+	req.Header.Set(echo.HeaderAccept, mimeSSZ)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, mimeSSZ, rec.Header().Get(echo.HeaderContentType))
+	require.Equal(t, "deneb", rec.Header().Get(handlers.HeaderConsensusVersion))
+	expected, err := fork.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, rec.Body.Bytes())
+}
+
+func TestBinderSSZ(t *testing.T) {
+	t.Parallel()
+	var bound sszRequest
+	route := &handlers.Route{
+		Handler: func(c handlers.Context) (any, error) {
+			return nil, c.Bind(&bound)
+		},
+	}
+	e := NewDefaultEngine()
+	e.POST("/blocks/:id", responseMiddleware(route))
+
+	req := httptest.NewRequest(
+		http.MethodPost, "/blocks/7", bytes.NewReader([]byte{1, 2, 3}),
+	)
+	req.Header.Set(echo.HeaderContentType, mimeSSZ)
+	req.Header.Set(handlers.HeaderConsensusVersion, "electra")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "7", bound.ID)
+	require.Equal(t, []byte{1, 2, 3}, bound.Body)
+	require.Equal(t, "electra", bound.ForkName)
+}
+
+func TestResponseMiddlewareStatus(t *testing.T) {
+	t.Parallel()
+	route := &handlers.Route{
+		Handler: func(handlers.Context) (any, error) {
+			return handlers.Status(http.StatusPartialContent), nil
+		},
+	}
+	e := NewDefaultEngine()
+	e.GET("/health", responseMiddleware(route))
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusPartialContent, rec.Code)
+	require.Empty(t, rec.Body.Bytes())
+}
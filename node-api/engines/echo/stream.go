@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/labstack/echo/v4"
+)
+
+// keepAliveInterval is the interval at which a comment is written to idle
+// event streams, so that dead connections are detected and closed.
+const keepAliveInterval = 15 * time.Second
+
+// streamResponse writes the events of the stream to the client as
+// Server-Sent Events. It blocks until the stream ends or the client
+// disconnects, and always closes the stream before returning.
+func streamResponse(c handlers.Context, stream handlers.Stream) error {
+	defer stream.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ":\n\n"); err != nil {
+				return err
+			}
+			res.Flush()
+		case event, ok := <-stream.Events():
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(
+				res, "event: %s\ndata: %s\n\n", event.Topic, data,
+			); err != nil {
+				return err
+			}
+			res.Flush()
+		}
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
@@ -66,9 +68,11 @@ func ConstructValidator() *validator.Validate {
 		"block_id":         ValidateBlockID,
 		"timestamp_id":     ValidateTimestampID,
 		"validator_id":     ValidateValidatorID,
+		"validator_index":  ValidateUint64,
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
 		"validator_status": ValidateValidatorStatus,
+		"event_topics":     ValidateEventTopics,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -165,6 +169,17 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
 
+// ValidateEventTopics checks if the provided field is a comma-separated list
+// of topics supported by the event stream.
+func ValidateEventTopics(fl validator.FieldLevel) bool {
+	for _, topic := range strings.Split(fl.Field().String(), ",") {
+		if !events.IsValidTopic(strings.TrimSpace(topic)) {
+			return false
+		}
+	}
+	return true
+}
+
 func validateAllowedStrings(
 	value string,
 	allowedValues map[string]bool,
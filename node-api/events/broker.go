@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"sync"
+)
+
+// defaultBufferSize is the number of events buffered for each subscription
+// before events are dropped for that subscription.
+const defaultBufferSize = 64
+
+// Broker fans out published events to all subscriptions interested in the
+// event's topic. Publishing never blocks: if a subscription is not consumed
+// fast enough, events are dropped for that subscription only.
+type Broker struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+}
+
+// NewBroker creates a new event broker.
+func NewBroker() *Broker {
+	return &Broker{
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe creates a new subscription to the given topics. The subscription
+// must be closed by the caller once it is no longer consumed.
+func (b *Broker) Subscribe(topics ...string) *Subscription {
+	sub := &Subscription{
+		broker: b,
+		topics: make(map[string]struct{}, len(topics)),
+		events: make(chan *Event, defaultBufferSize),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions[sub] = struct{}{}
+	return sub
+}
+
+// Publish sends the event to all subscriptions of its topic.
+func (b *Broker) Publish(event *Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subscriptions {
+		if _, ok := sub.topics[event.Topic]; !ok {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// The subscriber is too slow, drop the event for it.
+		}
+	}
+}
+
+// HasSubscribers returns true if at least one subscription is interested in
+// the given topic. It allows publishers to skip building expensive events.
+func (b *Broker) HasSubscribers(topic string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for sub := range b.subscriptions {
+		if _, ok := sub.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// unsubscribe removes the subscription from the broker and closes its events
+// channel.
+func (b *Broker) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscriptions[sub]; !ok {
+		return
+	}
+	delete(b.subscriptions, sub)
+	close(sub.events)
+}
+
+// Subscription receives the events published on its topics.
+type Subscription struct {
+	broker *Broker
+	topics map[string]struct{}
+	events chan *Event
+}
+
+// Events returns the channel on which the subscribed events are delivered.
+// The channel is closed once the subscription is closed.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+// Close removes the subscription from its broker. It is safe to call Close
+// more than once.
+func (s *Subscription) Close() {
+	s.broker.unsubscribe(s)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerFiltersByTopic(t *testing.T) {
+	t.Parallel()
+	broker := events.NewBroker()
+
+	head := broker.Subscribe(events.TopicHead)
+	defer head.Close()
+	all := broker.Subscribe(events.TopicHead, events.TopicBlock)
+	defer all.Close()
+
+	require.True(t, broker.HasSubscribers(events.TopicHead))
+	require.True(t, broker.HasSubscribers(events.TopicBlock))
+	require.False(t, broker.HasSubscribers(events.TopicPayloadAttributes))
+
+	broker.Publish(events.New(events.TopicBlock, &events.BlockData{Slot: 1}))
+	broker.Publish(events.New(events.TopicHead, &events.HeadData{Slot: 1}))
+
+	event := <-head.Events()
+	require.Equal(t, events.TopicHead, event.Topic)
+	require.Empty(t, head.Events())
+
+	event = <-all.Events()
+	require.Equal(t, events.TopicBlock, event.Topic)
+	event = <-all.Events()
+	require.Equal(t, events.TopicHead, event.Topic)
+}
+
+func TestBrokerClose(t *testing.T) {
+	t.Parallel()
+	broker := events.NewBroker()
+
+	sub := broker.Subscribe(events.TopicHead)
+	sub.Close()
+	sub.Close() // closing twice must be safe
+
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.False(t, broker.HasSubscribers(events.TopicHead))
+
+	// Publishing without subscribers must not block nor panic.
+	broker.Publish(events.New(events.TopicHead, &events.HeadData{}))
+}
+
+func TestBrokerDropsEventsForSlowSubscribers(t *testing.T) {
+	t.Parallel()
+	broker := events.NewBroker()
+
+	sub := broker.Subscribe(events.TopicBlock)
+	defer sub.Close()
+
+	// Publish more events than can be buffered without consuming any of
+	// them: Publish must never block.
+	for i := range uint64(1000) {
+		broker.Publish(events.New(events.TopicBlock, &events.BlockData{Slot: i}))
+	}
+
+	// The oldest events are delivered, the rest are dropped.
+	event := <-sub.Events()
+	require.Equal(t, uint64(0), event.Data.(*events.BlockData).Slot)
+	require.Less(t, len(sub.Events()), 1000)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// BlobSidecarDataFromConsensus builds the blob_sidecar event data of the given
+// sidecar, which belongs to the block with the given root.
+func BlobSidecarDataFromConsensus(
+	blockRoot common.Root,
+	sc *datypes.BlobSidecar,
+) *BlobSidecarData {
+	commitment := sc.GetKzgCommitment()
+	return &BlobSidecarData{
+		BlockRoot:     blockRoot,
+		Index:         sc.GetIndex(),
+		Slot:          sc.GetBeaconBlockHeader().GetSlot().Unwrap(),
+		KZGCommitment: hex.EncodeBytes(commitment[:]),
+		VersionedHash: commitment.ToVersionedHash(),
+	}
+}
+
+// PayloadAttributesFromEngine converts the payload attributes sent to the
+// execution client to their Beacon API representation.
+func PayloadAttributesFromEngine(
+	attrs *engineprimitives.PayloadAttributes,
+) *PayloadAttributes {
+	withdrawals := make([]*Withdrawal, len(attrs.Withdrawals))
+	for i, w := range attrs.Withdrawals {
+		withdrawals[i] = &Withdrawal{
+			Index:          w.Index.Unwrap(),
+			ValidatorIndex: w.Validator.Unwrap(),
+			Address:        w.Address,
+			Amount:         w.Amount.Unwrap(),
+		}
+	}
+	return &PayloadAttributes{
+		Timestamp:             attrs.Timestamp.Unwrap(),
+		PrevRandao:            common.Root(attrs.PrevRandao),
+		SuggestedFeeRecipient: attrs.SuggestedFeeRecipient,
+		Withdrawals:           withdrawals,
+		ParentBeaconBlockRoot: attrs.ParentBeaconBlockRoot,
+	}
+}
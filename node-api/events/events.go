@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+// Topics that can be subscribed to on the event stream, as defined by the
+// Beacon Node API.
+//
+// https://ethereum.github.io/beacon-APIs/#/Events/eventstream
+const (
+	TopicHead                = "head"
+	TopicBlock               = "block"
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	TopicBlobSidecar         = "blob_sidecar"
+	TopicPayloadAttributes   = "payload_attributes"
+)
+
+// IsValidTopic returns true if the given topic is supported by the event
+// stream.
+func IsValidTopic(topic string) bool {
+	switch topic {
+	case TopicHead,
+		TopicBlock,
+		TopicFinalizedCheckpoint,
+		TopicBlobSidecar,
+		TopicPayloadAttributes:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event is a single event published on a topic. Data is serialized to JSON
+// when written to the event stream.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+// New creates a new event for the given topic.
+func New(topic string, data any) *Event {
+	return &Event{
+		Topic: topic,
+		Data:  data,
+	}
+}
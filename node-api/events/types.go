@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// HeadData is the data of a head event, published when the chain head is
+// updated by a finalized block.
+type HeadData struct {
+	Slot            uint64      `json:"slot,string"`
+	Block           common.Root `json:"block"`
+	State           common.Root `json:"state"`
+	EpochTransition bool        `json:"epoch_transition"`
+	// Proposer duties do not depend on RANDAO in beacon-kit, hence the
+	// dependent roots are always the root of the parent block.
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+// BlockData is the data of a block event, published when a block is
+// finalized.
+type BlockData struct {
+	Slot                uint64      `json:"slot,string"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointData is the data of a finalized_checkpoint event,
+// published when the first block of an epoch is finalized.
+type FinalizedCheckpointData struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               uint64      `json:"epoch,string"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// BlobSidecarData is the data of a blob_sidecar event, published for each
+// blob sidecar of a finalized block.
+type BlobSidecarData struct {
+	BlockRoot     common.Root `json:"block_root"`
+	Index         uint64      `json:"index,string"`
+	Slot          uint64      `json:"slot,string"`
+	KZGCommitment string      `json:"kzg_commitment"`
+	VersionedHash common.Root `json:"versioned_hash"`
+}
+
+// PayloadAttributesEvent is the envelope of a payload_attributes event.
+type PayloadAttributesEvent struct {
+	Version string                 `json:"version"`
+	Data    *PayloadAttributesData `json:"data"`
+}
+
+// PayloadAttributesData is the data of a payload_attributes event, published
+// with the attributes that this node would use to build the payload of the
+// next block.
+type PayloadAttributesData struct {
+	// ProposerIndex is not known ahead of time since the next proposer is
+	// selected by CometBFT, hence it is always omitted.
+	ProposerIndex     string               `json:"proposer_index,omitempty"`
+	ProposalSlot      uint64               `json:"proposal_slot,string"`
+	ParentBlockNumber uint64               `json:"parent_block_number,string"`
+	ParentBlockRoot   common.Root          `json:"parent_block_root"`
+	ParentBlockHash   common.ExecutionHash `json:"parent_block_hash"`
+	PayloadAttributes *PayloadAttributes   `json:"payload_attributes"`
+}
+
+// PayloadAttributes is the Beacon API representation of the payload
+// attributes sent to the execution client.
+type PayloadAttributes struct {
+	Timestamp             uint64                  `json:"timestamp,string"`
+	PrevRandao            common.Root             `json:"prev_randao"`
+	SuggestedFeeRecipient common.ExecutionAddress `json:"suggested_fee_recipient"`
+	Withdrawals           []*Withdrawal           `json:"withdrawals"`
+	ParentBeaconBlockRoot common.Root             `json:"parent_beacon_block_root"`
+}
+
+// Withdrawal is the Beacon API representation of a withdrawal.
+type Withdrawal struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
@@ -22,6 +22,7 @@ package beacon
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
@@ -33,6 +34,7 @@ type Backend interface {
 	GenesisBackend
 	BlobBackend
 	BlockBackend
+	PoolBackend
 	RandaoBackend
 	StateBackend
 	ValidatorBackend
@@ -53,17 +55,26 @@ type RandaoBackend interface {
 }
 
 type BlobBackend interface {
-	BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*types.Sidecar, error)
+	BlobSidecarsByIndices(slot math.Slot, indices []uint64) (datypes.BlobSidecars, error)
 }
 
 type BlockBackend interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+	SignedBlockHeaderAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlockHeader, error)
+	SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error)
+	ForkVersionAtSlot(slot math.Slot) (common.Version, error)
+}
+
+type PoolBackend interface {
+	VoluntaryExits() []*ctypes.SignedVoluntaryExit
+	SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
 }
 
 type StateBackend interface {
 	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+	FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error)
 }
 
 type ValidatorBackend interface {
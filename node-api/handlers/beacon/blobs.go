@@ -25,6 +25,7 @@ import (
 	apitypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // GetBlobSidecars provides an implementation for the
@@ -60,7 +61,17 @@ func (h *Handler) GetBlobSidecars(c handlers.Context) (any, error) {
 		return nil, err
 	}
 
-	return apitypes.SidecarsResponse{
-		Data: blobSidecars,
-	}, nil
+	forkVersion, err := h.backend.ForkVersionAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*apitypes.Sidecar, len(blobSidecars))
+	for i, blobSidecar := range blobSidecars {
+		data[i] = apitypes.SidecarFromConsensus(blobSidecar)
+	}
+	return apitypes.NewSSZResponse(
+		version.Name(forkVersion),
+		apitypes.SidecarsResponse{Data: data},
+		&blobSidecars,
+	), nil
 }
@@ -21,9 +21,13 @@
 package beacon
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func (h *Handler) GetBlockRewards(c handlers.Context) (any, error) {
@@ -43,3 +47,102 @@ func (h *Handler) GetBlockRewards(c handlers.Context) (any, error) {
 	}
 	return beacontypes.NewResponse(rewards), nil
 }
+
+func (h *Handler) GetBlock(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.signedBlockFromBlockID(req.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := beacontypes.SignedBeaconBlockFromConsensus(blk, false)
+	if err != nil {
+		return nil, err
+	}
+	forkName := version.Name(blk.GetForkVersion())
+	return beacontypes.NewSSZResponse(forkName, beacontypes.BlockResponse{
+		Version:         forkName,
+		GenericResponse: beacontypes.NewResponse(data),
+	}, blk), nil
+}
+
+func (h *Handler) GetBlindedBlock(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlindedBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.signedBlockFromBlockID(req.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := beacontypes.SignedBeaconBlockFromConsensus(blk, true)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version:         version.Name(blk.GetForkVersion()),
+		GenericResponse: beacontypes.NewResponse(data),
+	}, nil
+}
+
+// GetBlockAttestations returns the attestations of a block, which are always
+// empty since beacon-kit does not use attestations.
+func (h *Handler) GetBlockAttestations(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlockAttestationsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.signedBlockFromBlockID(req.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.BlockResponse{
+		Version:         version.Name(blk.GetForkVersion()),
+		GenericResponse: beacontypes.NewResponse([]any{}),
+	}, nil
+}
+
+// PublishBlock handles a signed block published by a validator client. Blocks
+// are proposed and gossiped through CometBFT, so a published block is only
+// accepted if it already is the block finalized at its slot.
+func (h *Handler) PublishBlock(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostBlocksV2Request](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blk := req.SignedBeaconBlock
+	if blk == nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "block must be SSZ encoded")
+	}
+	if blk.GetSlot() == 0 {
+		return nil, errors.Wrap(types.ErrInvalidRequest, "genesis block cannot be published")
+	}
+	stored, err := h.backend.SignedBlockAtSlot(blk.GetSlot())
+	if err != nil {
+		return nil, err
+	}
+	if stored.HashTreeRoot() != blk.HashTreeRoot() {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest, "block is not part of the chain at slot %d", blk.GetSlot(),
+		)
+	}
+	return nil, nil //nolint:nilnil // the spec responds without a body
+}
+
+func (h *Handler) signedBlockFromBlockID(blockID string) (*ctypes.SignedBeaconBlock, error) {
+	slot, err := utils.SlotFromBlockID(blockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return h.backend.SignedBlockAtSlot(slot)
+}
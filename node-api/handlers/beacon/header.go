@@ -25,6 +25,7 @@ import (
 	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/utils"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func (h *Handler) GetBlockHeaders(c handlers.Context) (any, error) {
@@ -38,18 +39,7 @@ func (h *Handler) GetBlockHeaders(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	header, err := h.backend.BlockHeaderAtSlot(slot)
-	if err != nil {
-		return nil, err
-	}
-	return beacontypes.NewResponse(&beacontypes.BlockHeaderResponse{
-		Root:      header.GetBodyRoot(),
-		Canonical: true,
-		Header: &beacontypes.SignedBeaconBlockHeader{
-			Message:   beacontypes.BeaconBlockHeaderFromConsensus(header),
-			Signature: "", // TODO: implement
-		},
-	}), nil
+	return h.blockHeaderResponse(slot)
 }
 
 func (h *Handler) GetBlockHeaderByID(c handlers.Context) (any, error) {
@@ -63,16 +53,27 @@ func (h *Handler) GetBlockHeaderByID(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	header, err := h.backend.BlockHeaderAtSlot(slot)
+	return h.blockHeaderResponse(slot)
+}
+
+// blockHeaderResponse returns the signed header of the block at the given
+// slot, which can also be served SSZ encoded.
+func (h *Handler) blockHeaderResponse(slot math.Slot) (any, error) {
+	signedHeader, err := h.backend.SignedBlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := h.backend.ForkVersionAtSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(&beacontypes.BlockHeaderResponse{
-		Root:      header.GetBodyRoot(),
-		Canonical: true,
-		Header: &beacontypes.SignedBeaconBlockHeader{
-			Message:   beacontypes.BeaconBlockHeaderFromConsensus(header),
-			Signature: "", // TODO: implement
-		},
-	}), nil
+	return beacontypes.NewSSZResponse(
+		version.Name(forkVersion),
+		beacontypes.NewResponse(&beacontypes.BlockHeaderResponse{
+			Root:      signedHeader.GetHeader().HashTreeRoot(),
+			Canonical: true,
+			Header:    beacontypes.SignedBeaconBlockHeaderFromConsensus(signedHeader),
+		}),
+		signedHeader,
+	), nil
 }
@@ -63,5 +63,23 @@ func (h *Handler) GetStateFork(c handlers.Context) (any, error) {
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.NewResponse(fork), nil
+	return beacontypes.NewResponse(beacontypes.ForkFromConsensus(fork)), nil
+}
+
+func (h *Handler) GetFinalityCheckpoints(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetFinalityCheckpointsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	checkpoints, err := h.backend.FinalityCheckpointsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(checkpoints), nil
 }
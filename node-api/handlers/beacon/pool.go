@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetPoolVoluntaryExits returns the signed voluntary exits known to the node
+// that are not yet included in a block.
+func (h *Handler) GetPoolVoluntaryExits(handlers.Context) (any, error) {
+	return beacontypes.NewResponse(
+		beacontypes.VoluntaryExitsFromConsensus(h.backend.VoluntaryExits()),
+	), nil
+}
+
+// PostPoolVoluntaryExits submits a signed voluntary exit to the node's pool.
+func (h *Handler) PostPoolVoluntaryExits(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.SignedVoluntaryExit](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	exit, err := beacontypes.VoluntaryExitToConsensus(&req)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	if err = h.backend.SubmitVoluntaryExit(exit); err != nil {
+		return nil, err
+	}
+	return nil, nil //nolint:nilnil // the spec responds without a body
+}
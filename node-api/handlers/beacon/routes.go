@@ -51,7 +51,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/finality_checkpoints",
-			Handler: h.NotImplemented,
+			Handler: h.GetFinalityCheckpoints,
 		},
 		{
 			Method:  http.MethodGet,
@@ -136,12 +136,12 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "eth/v2/beacon/blocks",
-			Handler: h.NotImplemented,
+			Handler: h.PublishBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
@@ -156,7 +156,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/beacon/blocks/:block_id/attestations",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlockAttestations,
 		},
 		{
 			Method:  http.MethodGet,
@@ -186,7 +186,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blinded_blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlindedBlock,
 		},
 		{
 			Method:  http.MethodGet,
@@ -266,12 +266,12 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodGet,
@@ -21,6 +21,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -29,6 +30,7 @@ import (
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func BeaconBlockHeaderFromConsensus(h *ctypes.BeaconBlockHeader) *BeaconBlockHeader {
@@ -41,6 +43,14 @@ func BeaconBlockHeaderFromConsensus(h *ctypes.BeaconBlockHeader) *BeaconBlockHea
 	}
 }
 
+func ForkFromConsensus(f *ctypes.Fork) *Fork {
+	return &Fork{
+		PreviousVersion: f.PreviousVersion.String(),
+		CurrentVersion:  f.CurrentVersion.String(),
+		Epoch:           f.Epoch.Base10(),
+	}
+}
+
 func SignedBeaconBlockHeaderFromConsensus(h *ctypes.SignedBeaconBlockHeader) *SignedBeaconBlockHeader {
 	return &SignedBeaconBlockHeader{
 		Message:   BeaconBlockHeaderFromConsensus(h.Header),
@@ -117,3 +127,229 @@ func ValidatorToConsensus(v *Validator) (*ctypes.Validator, error) {
 		WithdrawableEpoch:          we,
 	}, nil
 }
+
+// SignedBeaconBlockFromConsensus converts a signed beacon block into its spec
+// representation. If blinded is set, the execution payload is replaced by its
+// header.
+func SignedBeaconBlockFromConsensus(
+	blk *ctypes.SignedBeaconBlock, blinded bool,
+) (*SignedBeaconBlock, error) {
+	message, err := BeaconBlockFromConsensus(blk.GetBeaconBlock(), blinded)
+	if err != nil {
+		return nil, err
+	}
+	signature := blk.GetSignature()
+	return &SignedBeaconBlock{
+		Message:   message,
+		Signature: hex.EncodeBytes(signature[:]),
+	}, nil
+}
+
+func BeaconBlockFromConsensus(blk *ctypes.BeaconBlock, blinded bool) (*BeaconBlock, error) {
+	body, err := BeaconBlockBodyFromConsensus(blk.GetBody(), blinded)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconBlock{
+		Slot:          blk.GetSlot().Base10(),
+		ProposerIndex: blk.GetProposerIndex().Base10(),
+		ParentRoot:    blk.GetParentBlockRoot().Hex(),
+		StateRoot:     blk.GetStateRoot().Hex(),
+		Body:          body,
+	}, nil
+}
+
+func BeaconBlockBodyFromConsensus(
+	body *ctypes.BeaconBlockBody, blinded bool,
+) (*BeaconBlockBody, error) {
+	eth1Data := body.GetEth1Data()
+	syncAggregate := body.GetSyncAggregate()
+	graffiti := body.GetGraffiti()
+	randaoReveal := body.GetRandaoReveal()
+	commitments := make([]string, len(body.GetBlobKzgCommitments()))
+	for i, c := range body.GetBlobKzgCommitments() {
+		commitments[i] = hex.EncodeBytes(c[:])
+	}
+
+	res := &BeaconBlockBody{
+		RandaoReveal: hex.EncodeBytes(randaoReveal[:]),
+		Eth1Data: &Eth1Data{
+			DepositRoot:  eth1Data.DepositRoot.Hex(),
+			DepositCount: eth1Data.DepositCount.Base10(),
+			BlockHash:    eth1Data.BlockHash.Hex(),
+		},
+		Graffiti:          hex.EncodeBytes(graffiti[:]),
+		ProposerSlashings: []any{},
+		AttesterSlashings: []any{},
+		Attestations:      []any{},
+		Deposits:          DepositsFromConsensus(body.GetDeposits()),
+		VoluntaryExits:    VoluntaryExitsFromConsensus(body.GetVoluntaryExits()),
+		SyncAggregate: &SyncAggregate{
+			SyncCommitteeBits:      hex.EncodeBytes(syncAggregate.SyncCommitteeBits[:]),
+			SyncCommitteeSignature: hex.EncodeBytes(syncAggregate.SyncCommitteeSignature[:]),
+		},
+		BLSToExecutionChanges: []any{},
+		BlobKZGCommitments:    commitments,
+	}
+
+	payload := body.GetExecutionPayload()
+	if blinded {
+		header, err := payload.ToHeader()
+		if err != nil {
+			return nil, err
+		}
+		res.ExecutionPayloadHeader = ExecutionPayloadHeaderFromConsensus(header)
+	} else {
+		res.ExecutionPayload = ExecutionPayloadFromConsensus(payload)
+	}
+
+	if version.EqualsOrIsAfter(body.GetForkVersion(), version.Electra()) {
+		requests, err := body.GetExecutionRequests()
+		if err != nil {
+			return nil, err
+		}
+		res.ExecutionRequests = ExecutionRequestsFromConsensus(requests)
+	}
+	return res, nil
+}
+
+func ExecutionPayloadFromConsensus(p *ctypes.ExecutionPayload) *ExecutionPayload {
+	txs := make([]string, len(p.GetTransactions()))
+	for i, tx := range p.GetTransactions() {
+		txs[i] = hex.EncodeBytes(tx)
+	}
+	withdrawals := make([]*Withdrawal, len(p.GetWithdrawals()))
+	for i, w := range p.GetWithdrawals() {
+		address := w.GetAddress()
+		withdrawals[i] = &Withdrawal{
+			Index:          w.GetIndex().Base10(),
+			ValidatorIndex: w.GetValidatorIndex().Base10(),
+			Address:        hex.EncodeBytes(address[:]),
+			Amount:         w.GetAmount().Base10(),
+		}
+	}
+	return &ExecutionPayload{
+		ExecutionPayloadFields: ExecutionPayloadFields{
+			ParentHash:    p.ParentHash.Hex(),
+			FeeRecipient:  hex.EncodeBytes(p.FeeRecipient[:]),
+			StateRoot:     hex.EncodeBytes(p.StateRoot[:]),
+			ReceiptsRoot:  hex.EncodeBytes(p.ReceiptsRoot[:]),
+			LogsBloom:     hex.EncodeBytes(p.LogsBloom[:]),
+			PrevRandao:    hex.EncodeBytes(p.Random[:]),
+			BlockNumber:   p.Number.Base10(),
+			GasLimit:      p.GasLimit.Base10(),
+			GasUsed:       p.GasUsed.Base10(),
+			Timestamp:     p.Timestamp.Base10(),
+			ExtraData:     hex.EncodeBytes(p.ExtraData),
+			BaseFeePerGas: p.BaseFeePerGas.Dec(),
+			BlockHash:     p.BlockHash.Hex(),
+			BlobGasUsed:   p.BlobGasUsed.Base10(),
+			ExcessBlobGas: p.ExcessBlobGas.Base10(),
+		},
+		Transactions: txs,
+		Withdrawals:  withdrawals,
+	}
+}
+
+func ExecutionPayloadHeaderFromConsensus(h *ctypes.ExecutionPayloadHeader) *ExecutionPayloadHeader {
+	return &ExecutionPayloadHeader{
+		ExecutionPayloadFields: ExecutionPayloadFields{
+			ParentHash:    h.ParentHash.Hex(),
+			FeeRecipient:  hex.EncodeBytes(h.FeeRecipient[:]),
+			StateRoot:     hex.EncodeBytes(h.StateRoot[:]),
+			ReceiptsRoot:  hex.EncodeBytes(h.ReceiptsRoot[:]),
+			LogsBloom:     hex.EncodeBytes(h.LogsBloom[:]),
+			PrevRandao:    hex.EncodeBytes(h.Random[:]),
+			BlockNumber:   h.Number.Base10(),
+			GasLimit:      h.GasLimit.Base10(),
+			GasUsed:       h.GasUsed.Base10(),
+			Timestamp:     h.Timestamp.Base10(),
+			ExtraData:     hex.EncodeBytes(h.ExtraData),
+			BaseFeePerGas: h.BaseFeePerGas.Dec(),
+			BlockHash:     h.BlockHash.Hex(),
+			BlobGasUsed:   h.BlobGasUsed.Base10(),
+			ExcessBlobGas: h.ExcessBlobGas.Base10(),
+		},
+		TransactionsRoot: h.TransactionsRoot.Hex(),
+		WithdrawalsRoot:  h.WithdrawalsRoot.Hex(),
+	}
+}
+
+func DepositsFromConsensus(deposits []*ctypes.Deposit) []*Deposit {
+	res := make([]*Deposit, len(deposits))
+	for i, d := range deposits {
+		res[i] = &Deposit{
+			Pubkey:                d.Pubkey.String(),
+			WithdrawalCredentials: hex.EncodeBytes(d.Credentials[:]),
+			Amount:                d.Amount.Base10(),
+			Signature:             d.Signature.String(),
+			Index:                 strconv.FormatUint(d.Index, 10),
+		}
+	}
+	return res
+}
+
+func VoluntaryExitsFromConsensus(exits []*ctypes.SignedVoluntaryExit) []*SignedVoluntaryExit {
+	res := make([]*SignedVoluntaryExit, len(exits))
+	for i, e := range exits {
+		res[i] = &SignedVoluntaryExit{
+			Message: &VoluntaryExit{
+				Epoch:          e.Message.Epoch.Base10(),
+				ValidatorIndex: e.Message.ValidatorIndex.Base10(),
+			},
+			Signature: e.Signature.String(),
+		}
+	}
+	return res
+}
+
+// VoluntaryExitToConsensus parses a signed voluntary exit submitted to the
+// pool.
+func VoluntaryExitToConsensus(e *SignedVoluntaryExit) (*ctypes.SignedVoluntaryExit, error) {
+	if e.Message == nil {
+		return nil, errors.New("missing voluntary exit message")
+	}
+	epoch, err := math.U64FromString(e.Message.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing epoch: %w", err)
+	}
+	index, err := math.U64FromString(e.Message.ValidatorIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing validator index: %w", err)
+	}
+	sig, err := parser.ConvertSignature(e.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signature: %w", err)
+	}
+	return &ctypes.SignedVoluntaryExit{
+		Message: &ctypes.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: index,
+		},
+		Signature: sig,
+	}, nil
+}
+
+func ExecutionRequestsFromConsensus(r *ctypes.ExecutionRequests) *ExecutionRequests {
+	withdrawals := make([]*WithdrawalRequest, len(r.Withdrawals))
+	for i, w := range r.Withdrawals {
+		withdrawals[i] = &WithdrawalRequest{
+			SourceAddress:   hex.EncodeBytes(w.SourceAddress[:]),
+			ValidatorPubkey: w.ValidatorPubKey.String(),
+			Amount:          w.Amount.Base10(),
+		}
+	}
+	consolidations := make([]*ConsolidationRequest, len(r.Consolidations))
+	for i, c := range r.Consolidations {
+		consolidations[i] = &ConsolidationRequest{
+			SourceAddress: hex.EncodeBytes(c.SourceAddress[:]),
+			SourcePubkey:  c.SourcePubKey.String(),
+			TargetPubkey:  c.TargetPubKey.String(),
+		}
+	}
+	return &ExecutionRequests{
+		Deposits:       DepositsFromConsensus(r.Deposits),
+		Withdrawals:    withdrawals,
+		Consolidations: consolidations,
+	}
+}
@@ -21,8 +21,12 @@
 package types
 
 import (
+	"fmt"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	sszutil "github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 type GetGenesisRequest struct{}
@@ -110,9 +114,28 @@ type PostBlocksV1Request struct {
 	BeaconBlock         ctypes.BeaconBlock `json:"beacon_block"`
 }
 
+// PostBlocksV2Request is the request of a published signed block, which is
+// only accepted SSZ encoded.
 type PostBlocksV2Request struct {
-	PostBlocksV1Request
-	BroadcastValidation string `json:"broadcast_validation" validate:"required,broadcast_validation"`
+	BroadcastValidation string                    `query:"broadcast_validation"`
+	SignedBeaconBlock   *ctypes.SignedBeaconBlock `json:"-"`
+}
+
+// UnmarshalSSZBody decodes the SSZ encoded signed block of the given fork.
+func (r *PostBlocksV2Request) UnmarshalSSZBody(bz []byte, forkName string) error {
+	forkVersion, ok := version.FromName(forkName)
+	if !ok {
+		return fmt.Errorf("unknown fork %q", forkName)
+	}
+	blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(forkVersion)
+	if err != nil {
+		return err
+	}
+	if err = sszutil.Unmarshal(bz, blk); err != nil {
+		return err
+	}
+	r.SignedBeaconBlock = blk
+	return nil
 }
 
 type GetBlocksRequest struct {
@@ -21,7 +21,10 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constraints"
 )
 
 type GenericResponse struct {
@@ -46,6 +49,46 @@ type BlockResponse struct {
 	GenericResponse
 }
 
+// ConsensusVersion returns the fork name of the block.
+func (r BlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// SSZResponse wraps a response of fork versioned data so that it can also be
+// served as the SSZ encoding of the data.
+type SSZResponse struct {
+	version  string
+	response any
+	sszData  constraints.SSZMarshaler
+}
+
+// NewSSZResponse creates a new response for data of the given fork, serving
+// response as JSON and sszData as SSZ.
+func NewSSZResponse(
+	version string, response any, sszData constraints.SSZMarshaler,
+) *SSZResponse {
+	return &SSZResponse{
+		version:  version,
+		response: response,
+		sszData:  sszData,
+	}
+}
+
+// MarshalJSON returns the JSON encoding of the wrapped response.
+func (r *SSZResponse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.response)
+}
+
+// MarshalSSZ returns the SSZ encoding of the response data.
+func (r *SSZResponse) MarshalSSZ() ([]byte, error) {
+	return r.sszData.MarshalSSZ()
+}
+
+// ConsensusVersion returns the fork name of the response data.
+func (r *SSZResponse) ConsensusVersion() string {
+	return r.version
+}
+
 type StateResponse struct {
 	Version             string `json:"version"`
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
@@ -53,6 +96,11 @@ type StateResponse struct {
 	Data                any    `json:"data"`
 }
 
+// ConsensusVersion returns the fork name of the state.
+func (r StateResponse) ConsensusVersion() string {
+	return r.Version
+}
+
 type BlockHeaderResponse struct {
 	Root      common.Root              `json:"root"`
 	Canonical bool                     `json:"canonical"`
@@ -86,6 +134,26 @@ type GenesisResponse struct {
 	Data GenesisData `json:"data"`
 }
 
+// Fork is the spec representation of a fork.
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// Checkpoint is the spec representation of a checkpoint.
+type Checkpoint struct {
+	Epoch string      `json:"epoch"`
+	Root  common.Root `json:"root"`
+}
+
+// FinalityCheckpointsData is the data of the finality checkpoints of a state.
+type FinalityCheckpointsData struct {
+	PreviousJustified *Checkpoint `json:"previous_justified"`
+	CurrentJustified  *Checkpoint `json:"current_justified"`
+	Finalized         *Checkpoint `json:"finalized"`
+}
+
 type RootData struct {
 	Root common.Root `json:"root"`
 }
@@ -141,3 +209,126 @@ type Sidecar struct {
 type SidecarsResponse struct {
 	Data []*Sidecar `json:"data"`
 }
+
+// SignedBeaconBlock is the spec representation of a signed beacon block.
+type SignedBeaconBlock struct {
+	Message   *BeaconBlock `json:"message"`
+	Signature string       `json:"signature"`
+}
+
+type BeaconBlock struct {
+	Slot          string           `json:"slot"`
+	ProposerIndex string           `json:"proposer_index"`
+	ParentRoot    string           `json:"parent_root"`
+	StateRoot     string           `json:"state_root"`
+	Body          *BeaconBlockBody `json:"body"`
+}
+
+// BeaconBlockBody is the spec representation of a beacon block body. Exactly
+// one of ExecutionPayload and ExecutionPayloadHeader is set, depending on
+// whether the block is blinded.
+//
+// NOTE: slashings, attestations, voluntary exits and BLS to execution
+// changes are unused in beacon-kit and hence always empty.
+type BeaconBlockBody struct {
+	RandaoReveal           string                  `json:"randao_reveal"`
+	Eth1Data               *Eth1Data               `json:"eth1_data"`
+	Graffiti               string                  `json:"graffiti"`
+	ProposerSlashings      []any                   `json:"proposer_slashings"`
+	AttesterSlashings      []any                   `json:"attester_slashings"`
+	Attestations           []any                   `json:"attestations"`
+	Deposits               []*Deposit              `json:"deposits"`
+	VoluntaryExits         []*SignedVoluntaryExit  `json:"voluntary_exits"`
+	SyncAggregate          *SyncAggregate          `json:"sync_aggregate"`
+	ExecutionPayload       *ExecutionPayload       `json:"execution_payload,omitempty"`
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header,omitempty"`
+	BLSToExecutionChanges  []any                   `json:"bls_to_execution_changes"`
+	BlobKZGCommitments     []string                `json:"blob_kzg_commitments"`
+	ExecutionRequests      *ExecutionRequests      `json:"execution_requests,omitempty"`
+}
+
+type Eth1Data struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+type Deposit struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+	Index                 string `json:"index"`
+}
+
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type SignedVoluntaryExit struct {
+	Message   *VoluntaryExit `json:"message" validate:"required"`
+	Signature string         `json:"signature" validate:"required"`
+}
+
+type SyncAggregate struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+type ExecutionPayload struct {
+	ExecutionPayloadFields
+	Transactions []string      `json:"transactions"`
+	Withdrawals  []*Withdrawal `json:"withdrawals"`
+}
+
+type ExecutionPayloadHeader struct {
+	ExecutionPayloadFields
+	TransactionsRoot string `json:"transactions_root"`
+	WithdrawalsRoot  string `json:"withdrawals_root"`
+}
+
+// ExecutionPayloadFields are the fields shared by the execution payload and
+// its header.
+type ExecutionPayloadFields struct {
+	ParentHash    string `json:"parent_hash"`
+	FeeRecipient  string `json:"fee_recipient"`
+	StateRoot     string `json:"state_root"`
+	ReceiptsRoot  string `json:"receipts_root"`
+	LogsBloom     string `json:"logs_bloom"`
+	PrevRandao    string `json:"prev_randao"`
+	BlockNumber   string `json:"block_number"`
+	GasLimit      string `json:"gas_limit"`
+	GasUsed       string `json:"gas_used"`
+	Timestamp     string `json:"timestamp"`
+	ExtraData     string `json:"extra_data"`
+	BaseFeePerGas string `json:"base_fee_per_gas"`
+	BlockHash     string `json:"block_hash"`
+	BlobGasUsed   string `json:"blob_gas_used"`
+	ExcessBlobGas string `json:"excess_blob_gas"`
+}
+
+type Withdrawal struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+type ExecutionRequests struct {
+	Deposits       []*Deposit              `json:"deposits"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals"`
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+type WithdrawalRequest struct {
+	SourceAddress   string `json:"source_address"`
+	ValidatorPubkey string `json:"validator_pubkey"`
+	Amount          string `json:"amount"`
+}
+
+type ConsolidationRequest struct {
+	SourceAddress string `json:"source_address"`
+	SourcePubkey  string `json:"source_pubkey"`
+	TargetPubkey  string `json:"target_pubkey"`
+}
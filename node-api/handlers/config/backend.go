@@ -20,12 +20,20 @@
 
 package config
 
-import "github.com/berachain/beacon-kit/chain"
+import (
+	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+)
 
 type Backend interface {
 	SpecBackend
+	ForkBackend
 }
 
 type SpecBackend interface {
 	Spec() (chain.Spec, error)
 }
+
+type ForkBackend interface {
+	ForkSchedule() ([]*ctypes.Fork, error)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+func (h *Handler) GetForkSchedule(handlers.Context) (any, error) {
+	schedule, err := h.backend.ForkSchedule()
+	if err != nil {
+		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get fork schedule: %v", err)
+	}
+	forks := make([]*beacontypes.Fork, len(schedule))
+	for i, fork := range schedule {
+		forks[i] = beacontypes.ForkFromConsensus(fork)
+	}
+	return types.ForkScheduleResponse{Data: forks}, nil
+}
+
+func (h *Handler) GetDepositContract(handlers.Context) (any, error) {
+	cs, err := h.backend.Spec()
+	if err != nil {
+		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get spec: %v", err)
+	}
+	return types.DepositContractResponse{Data: types.DepositContractData{
+		ChainID: math.U64(cs.DepositEth1ChainID()).Base10(),
+		Address: cs.DepositContractAddress().String(),
+	}}, nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // InactivityPenaltyQuotientPlaceholder is a placeholder value for the inactivity penalty quotient.
@@ -37,8 +38,23 @@ func (h *Handler) GetSpec(handlers.Context) (any, error) {
 	if err != nil {
 		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get spec: %v", err)
 	}
+	// The fork versions and epochs are taken from the fork schedule, so that
+	// both endpoints always agree.
+	schedule, err := h.backend.ForkSchedule()
+	if err != nil {
+		return nil, handlers.NewHTTPError(http.StatusInternalServerError, "failed to get fork schedule: %v", err)
+	}
+	genesisFork := schedule[0]
+	electraFork := genesisFork
+	for _, fork := range schedule {
+		if version.Equals(fork.CurrentVersion, version.Electra()) {
+			electraFork = fork
+		}
+	}
+
 	return types.SpecResponse{Data: types.SpecData{
 		DepositContractAddress: cs.DepositContractAddress().String(),
+		DepositChainID:         math.U64(cs.DepositEth1ChainID()).Base10(),
 
 		// Network ID is same as eth1 chain ID.
 		DepositNetworkID: math.U64(cs.DepositEth1ChainID()).Base10(),
@@ -49,5 +65,10 @@ func (h *Handler) GetSpec(handlers.Context) (any, error) {
 		// versions like Deneb, Deneb1 etc once we implement slashing for inactivity.
 		InactivityPenaltyQuotient:       InactivityPenaltyQuotientPlaceholder,
 		InactivityPenaltyQuotientAltair: InactivityPenaltyQuotientPlaceholder,
+
+		SlotsPerEpoch:      math.U64(cs.SlotsPerEpoch()).Base10(),
+		GenesisForkVersion: genesisFork.CurrentVersion.String(),
+		ElectraForkVersion: electraFork.CurrentVersion.String(),
+		ElectraForkEpoch:   electraFork.Epoch.Base10(),
 	}}, nil
 }
@@ -20,14 +20,34 @@
 
 package types
 
+import beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+
 type SpecResponse struct {
 	Data SpecData `json:"data"`
 }
 
 type SpecData struct {
 	DepositContractAddress          string `json:"DEPOSIT_CONTRACT_ADDRESS"`
+	DepositChainID                  string `json:"DEPOSIT_CHAIN_ID"`
 	DepositNetworkID                string `json:"DEPOSIT_NETWORK_ID"`
 	DomainAggregateAndProof         string `json:"DOMAIN_AGGREGATE_AND_PROOF"`
 	InactivityPenaltyQuotient       string `json:"INACTIVITY_PENALTY_QUOTIENT"`
 	InactivityPenaltyQuotientAltair string `json:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
+	SlotsPerEpoch                   string `json:"SLOTS_PER_EPOCH"`
+	GenesisForkVersion              string `json:"GENESIS_FORK_VERSION"`
+	ElectraForkVersion              string `json:"ELECTRA_FORK_VERSION"`
+	ElectraForkEpoch                string `json:"ELECTRA_FORK_EPOCH"`
+}
+
+type ForkScheduleResponse struct {
+	Data []*beacontypes.Fork `json:"data"`
+}
+
+type DepositContractResponse struct {
+	Data DepositContractData `json:"data"`
+}
+
+type DepositContractData struct {
+	ChainID string `json:"chain_id"`
+	Address string `json:"address"`
 }
@@ -55,13 +55,14 @@ func (h *Handler) GetState(c handlers.Context) (any, error) {
 		return nil, err
 	}
 
-	return beacontypes.StateResponse{
+	forkName := version.Name(fork.CurrentVersion)
+	return beacontypes.NewSSZResponse(forkName, beacontypes.StateResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
 		Finalized: true,
 		// Never optimistic since we only return finalized data
 		ExecutionOptimistic: false,
 
-		Version: version.Name(fork.CurrentVersion),
+		Version: forkName,
 		Data:    beaconState,
-	}, nil
+	}, beaconState), nil
 }
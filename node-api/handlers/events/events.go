@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	eventstypes "github.com/berachain/beacon-kit/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetEvents subscribes to the requested topics and returns the subscription,
+// which the engine streams to the client as Server-Sent Events.
+func (h *Handler) GetEvents(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[eventstypes.GetEventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]string, 0, len(req.Topics))
+	for _, topic := range req.Topics {
+		for _, t := range strings.Split(topic, ",") {
+			topics = append(topics, strings.TrimSpace(t))
+		}
+	}
+	return h.broker.Subscribe(topics...), nil
+}
@@ -22,15 +22,19 @@ package events
 
 import "github.com/berachain/beacon-kit/node-api/handlers"
 
+// Handler is the handler for the events API.
 type Handler struct {
 	*handlers.BaseHandler
+	broker Broker
 }
 
-func NewHandler() *Handler {
+// NewHandler creates a new handler for the events API.
+func NewHandler(broker Broker) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		broker: broker,
 	}
 	return h
 }
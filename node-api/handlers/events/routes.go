@@ -33,7 +33,7 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/events",
-			Handler: h.NotImplemented,
+			Handler: h.GetEvents,
 		},
 	})
 }
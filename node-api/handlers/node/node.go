@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetIdentity returns the p2p identity of the node.
+func (h *Handler) GetIdentity(handlers.Context) (any, error) {
+	identity, err := h.backend.NodeIdentity()
+	if err != nil {
+		return nil, err
+	}
+	return types.DataResponse{Data: identity}, nil
+}
+
+// GetVersion returns the version of the node.
+func (h *Handler) GetVersion(handlers.Context) (any, error) {
+	return types.DataResponse{
+		Data: types.VersionData{Version: h.backend.NodeVersion()},
+	}, nil
+}
+
+// GetSyncing returns the sync status of the node.
+func (h *Handler) GetSyncing(handlers.Context) (any, error) {
+	syncing, err := h.backend.SyncingStatus()
+	if err != nil {
+		return nil, err
+	}
+	return types.DataResponse{Data: syncing}, nil
+}
+
+// GetHealth responds with 200 if the node is ready, 206 (or the requested
+// syncing status) while it is syncing, and 503 if it is not started or the
+// execution client is offline.
+func (h *Handler) GetHealth(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetHealthRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	syncingStatus := http.StatusPartialContent
+	if req.SyncingStatus != "" {
+		syncingStatus, err = strconv.Atoi(req.SyncingStatus)
+		if err != nil || syncingStatus < 100 || syncingStatus > 599 {
+			return nil, handlertypes.ErrInvalidRequest
+		}
+	}
+
+	syncing, err := h.backend.SyncingStatus()
+	switch {
+	case err != nil, syncing.ELOffline:
+		return handlers.Status(http.StatusServiceUnavailable), nil
+	case syncing.IsSyncing:
+		return handlers.Status(syncingStatus), nil
+	default:
+		return handlers.Status(http.StatusOK), nil
+	}
+}
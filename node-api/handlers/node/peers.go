@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// GetPeers returns the peers of the node, filtered by the requested states
+// and directions.
+func (h *Handler) GetPeers(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetPeersRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*types.PeerData, 0)
+	for _, peer := range h.backend.NodePeers() {
+		if len(req.States) > 0 && !slices.Contains(req.States, peer.State) {
+			continue
+		}
+		if len(req.Directions) > 0 &&
+			!slices.Contains(req.Directions, peer.Direction) {
+			continue
+		}
+		peers = append(peers, peer)
+	}
+	return types.PeersResponse{
+		Data: peers,
+		Meta: types.PeersMeta{Count: len(peers)},
+	}, nil
+}
+
+// GetPeer returns the peer of the node with the requested ID.
+func (h *Handler) GetPeer(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetPeerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range h.backend.NodePeers() {
+		if peer.PeerID == req.PeerID {
+			return types.DataResponse{Data: peer}, nil
+		}
+	}
+	return nil, errors.Wrapf(handlertypes.ErrNotFound, "peer %s", req.PeerID)
+}
+
+// GetPeerCount returns the number of peers of the node by state. CometBFT
+// only tracks connected peers.
+func (h *Handler) GetPeerCount(handlers.Context) (any, error) {
+	return types.DataResponse{
+		Data: types.PeerCountData{
+			Disconnected:  "0",
+			Connecting:    "0",
+			Connected:     strconv.Itoa(len(h.backend.NodePeers())),
+			Disconnecting: "0",
+		},
+	}, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// Peer states and directions as defined by the Beacon API spec.
+const (
+	PeerStateConnected = "connected"
+	DirectionInbound   = "inbound"
+	DirectionOutbound  = "outbound"
+)
+
+type DataResponse struct {
+	Data any `json:"data"`
+}
+
+type PeersResponse struct {
+	Data []*PeerData `json:"data"`
+	Meta PeersMeta   `json:"meta"`
+}
+
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+type IdentityData struct {
+	PeerID             string       `json:"peer_id"`
+	ENR                string       `json:"enr"`
+	P2PAddresses       []string     `json:"p2p_addresses"`
+	DiscoveryAddresses []string     `json:"discovery_addresses"`
+	Metadata           MetadataData `json:"metadata"`
+}
+
+type MetadataData struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets"`
+}
+
+type PeerData struct {
+	PeerID             string `json:"peer_id"`
+	ENR                string `json:"enr"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+type PeerCountData struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+type SyncingData struct {
+	HeadSlot     string `json:"head_slot"`
+	SyncDistance string `json:"sync_distance"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
+
+type VersionData struct {
+	Version string `json:"version"`
+}
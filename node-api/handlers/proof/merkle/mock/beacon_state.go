@@ -65,6 +65,13 @@ func NewBeaconStateWith(
 	bsm.TotalSlashing = 0
 	if version.EqualsOrIsAfter(bsm.GetForkVersion(), version.Electra()) {
 		bsm.PendingPartialWithdrawals = []*types.PendingPartialWithdrawal{}
+		bsm.ConsolidationBalanceToConsume = 0
+		bsm.EarliestConsolidationEpoch = 0
+		bsm.PendingConsolidations = []*types.PendingConsolidation{}
+		bsm.DepositRequestsStartIndex = 0
+		bsm.ExitBalanceToConsume = 0
+		bsm.EarliestExitEpoch = 0
+		bsm.PendingDeposits = []*types.Deposit{}
 	}
 
 	return bsm
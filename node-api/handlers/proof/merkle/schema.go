@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+//nolint:gochecknoglobals,mnd // schemas are static and sized by the spec.
+var (
+	// beaconStateFieldsDeneb are the SSZ fields of the BeaconState in the
+	// Deneb forks, named as in the consensus specs.
+	beaconStateFieldsDeneb = []*schema.Field{
+		schema.NewField("genesis_validators_root", schema.B32()),
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("fork", schema.DefineContainer(
+			schema.NewField("previous_version", schema.B4()),
+			schema.NewField("current_version", schema.B4()),
+			schema.NewField("epoch", schema.U64()),
+		)),
+		schema.NewField("latest_block_header", schema.DefineContainer(
+			schema.NewField("slot", schema.U64()),
+			schema.NewField("proposer_index", schema.U64()),
+			schema.NewField("parent_root", schema.B32()),
+			schema.NewField("state_root", schema.B32()),
+			schema.NewField("body_root", schema.B32()),
+		)),
+		schema.NewField("block_roots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("state_roots", schema.DefineList(schema.B32(), 8192)),
+		schema.NewField("eth1_data", schema.DefineContainer(
+			schema.NewField("deposit_root", schema.B32()),
+			schema.NewField("deposit_count", schema.U64()),
+			schema.NewField("block_hash", schema.B32()),
+		)),
+		schema.NewField("eth1_deposit_index", schema.U64()),
+		schema.NewField("latest_execution_payload_header", schema.DefineContainer(
+			schema.NewField("parent_hash", schema.B32()),
+			schema.NewField("fee_recipient", schema.B20()),
+			schema.NewField("state_root", schema.B32()),
+			schema.NewField("receipts_root", schema.B32()),
+			schema.NewField("logs_bloom", schema.B256()),
+			schema.NewField("prev_randao", schema.B32()),
+			schema.NewField("block_number", schema.U64()),
+			schema.NewField("gas_limit", schema.U64()),
+			schema.NewField("gas_used", schema.U64()),
+			schema.NewField("timestamp", schema.U64()),
+			schema.NewField("extra_data", schema.DefineByteList(32)),
+			schema.NewField("base_fee_per_gas", schema.U256()),
+			schema.NewField("block_hash", schema.B32()),
+			schema.NewField("transactions_root", schema.B32()),
+			schema.NewField("withdrawals_root", schema.B32()),
+			schema.NewField("blob_gas_used", schema.U64()),
+			schema.NewField("excess_blob_gas", schema.U64()),
+		)),
+		schema.NewField("validators", schema.DefineList(schema.DefineContainer(
+			schema.NewField("pubkey", schema.B48()),
+			schema.NewField("withdrawal_credentials", schema.B32()),
+			schema.NewField("effective_balance", schema.U64()),
+			schema.NewField("slashed", schema.Bool()),
+			schema.NewField("activation_eligibility_epoch", schema.U64()),
+			schema.NewField("activation_epoch", schema.U64()),
+			schema.NewField("exit_epoch", schema.U64()),
+			schema.NewField("withdrawable_epoch", schema.U64()),
+		), constants.ValidatorsRegistryLimit)),
+		schema.NewField(
+			"balances", schema.DefineList(schema.U64(), constants.ValidatorsRegistryLimit),
+		),
+		schema.NewField("randao_mixes", schema.DefineList(schema.B32(), 65536)),
+		schema.NewField("next_withdrawal_index", schema.U64()),
+		schema.NewField("next_withdrawal_validator_index", schema.U64()),
+		schema.NewField(
+			"slashings", schema.DefineList(schema.U64(), constants.ValidatorsRegistryLimit),
+		),
+		schema.NewField("total_slashing", schema.U64()),
+	}
+
+	// additionalBeaconStateFieldsElectra are the SSZ fields appended to the
+	// BeaconState in the Electra forks.
+	additionalBeaconStateFieldsElectra = []*schema.Field{
+		schema.NewField("pending_partial_withdrawals", schema.DefineList(schema.DefineContainer(
+			schema.NewField("validator_index", schema.U64()),
+			schema.NewField("amount", schema.U64()),
+			schema.NewField("withdrawable_epoch", schema.U64()),
+		), constants.PendingPartialWithdrawalsLimit)),
+		schema.NewField("consolidation_balance_to_consume", schema.U64()),
+		schema.NewField("earliest_consolidation_epoch", schema.U64()),
+		schema.NewField("pending_consolidations", schema.DefineList(schema.DefineContainer(
+			schema.NewField("source_index", schema.U64()),
+			schema.NewField("target_index", schema.U64()),
+		), constants.PendingConsolidationsLimit)),
+		schema.NewField("deposit_requests_start_index", schema.U64()),
+		schema.NewField("exit_balance_to_consume", schema.U64()),
+		schema.NewField("earliest_exit_epoch", schema.U64()),
+		schema.NewField("pending_deposits", schema.DefineList(schema.DefineContainer(
+			schema.NewField("pubkey", schema.B48()),
+			schema.NewField("withdrawal_credentials", schema.B32()),
+			schema.NewField("amount", schema.U64()),
+			schema.NewField("signature", schema.B96()),
+			schema.NewField("index", schema.U64()),
+		), constants.PendingDepositsLimit)),
+	}
+
+	// beaconStateSchemaDeneb is the schema for the BeaconState in the Deneb forks.
+	beaconStateSchemaDeneb = schema.DefineContainer(beaconStateFieldsDeneb...)
+
+	// beaconStateSchemaElectra is the schema for the BeaconState in the Electra forks.
+	beaconStateSchemaElectra = schema.DefineContainer(
+		append(beaconStateFieldsDeneb, additionalBeaconStateFieldsElectra...)...,
+	)
+)
+
+// GetBeaconStateSchema returns the SSZ schema of the BeaconState for the
+// given fork version. Field names follow the consensus specs, so an object
+// path into the state reads e.g. "validators/12/effective_balance".
+func GetBeaconStateSchema(forkVersion common.Version) (schema.SSZType, error) {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return beaconStateSchemaElectra, nil
+	} else if version.EqualsOrIsAfter(forkVersion, version.Deneb()) {
+		return beaconStateSchemaDeneb, nil
+	}
+	return nil, fmt.Errorf("unsupported fork version: %s", forkVersion)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	stdmath "math"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// ErrInvalidObjectPath is returned when an object path cannot be resolved
+// against the beacon state schema or the beacon state itself.
+var ErrInvalidObjectPath = errors.New("invalid object path")
+
+// StatePathProof is a merkle proof of the chunk at an object path in the
+// beacon state, verifiable against the beacon block root.
+type StatePathProof struct {
+	// GIndex is the generalized index of the leaf in the beacon block.
+	GIndex uint64
+	// Offset is the byte offset of the value within the leaf chunk.
+	Offset uint8
+	// Leaf is the 32-byte chunk at the object path.
+	Leaf common.Root
+	// Proof is the list of sibling hashes from the leaf to the block root.
+	Proof []common.Root
+	// BeaconBlockRoot is the root the proof verifies against.
+	BeaconBlockRoot common.Root
+}
+
+// ProveStatePathInBlock generates a proof for the chunk at the given object
+// path in the beacon state, e.g. "validators/12/effective_balance". The
+// generalized index is computed from the beacon state schema of the state's
+// fork version. The proof is then verified against the beacon block root as
+// a sanity check. It uses the fastssz library to generate the proof.
+func ProveStatePathInBlock(
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+	path merkle.ObjectPath,
+) (*StatePathProof, error) {
+	stateSchema, err := GetBeaconStateSchema(bsm.GetForkVersion())
+	if err != nil {
+		return nil, err
+	}
+	_, gIndexState, offset, err := path.GetGeneralizedIndex(stateSchema)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: %s", path, err.Error())
+	}
+	gIndexBlock := uint64(merkle.GeneralizedIndices{
+		StateGIndexBlock, merkle.GeneralizedIndex(gIndexState),
+	}.Concat())
+	if gIndexBlock > stdmath.MaxInt64 {
+		return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: path too deep", path)
+	}
+
+	// Get the proof of the object in the beacon state.
+	pathInStateProof, leaf, err := proveGIndexInState(bsm, gIndexState, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Then get the proof of the beacon state in the beacon block.
+	stateInBlockProof, err := ProveBeaconStateInBlock(bbh, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sanity check that the combined proof verifies against our beacon root.
+	//
+	//nolint:gocritic // ok.
+	combinedProof := append(pathInStateProof, stateInBlockProof...)
+	beaconRoot := bbh.HashTreeRoot()
+	if !merkle.VerifyProof(beaconRoot, leaf, gIndexBlock, combinedProof) {
+		return nil, errors.Wrapf(
+			errors.New("state path proof failed to verify against beacon root"),
+			"beacon root: 0x%s", beaconRoot,
+		)
+	}
+
+	return &StatePathProof{
+		GIndex:          gIndexBlock,
+		Offset:          offset,
+		Leaf:            leaf,
+		Proof:           combinedProof,
+		BeaconBlockRoot: beaconRoot,
+	}, nil
+}
+
+// proveGIndexInState generates a proof for the given generalized index in the
+// beacon state. It uses the fastssz library to generate the proof.
+func proveGIndexInState(
+	bsm types.BeaconStateMarshallable, gIndex uint64, path merkle.ObjectPath,
+) ([]common.Root, common.Root, error) {
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	// The schema only bounds list indices by their limit, so make sure the
+	// node is actually populated before proving it. fastssz panics when
+	// descending below a zero-hash leaf.
+	// #nosec G115 -- bounded by the caller.
+	if _, err = stateProofTree.Get(int(gIndex)); err != nil {
+		return nil, common.Root{}, errors.Wrapf(
+			ErrInvalidObjectPath, "%s: not present in beacon state", path,
+		)
+	}
+
+	// #nosec G115 -- bounded by the caller.
+	pathInStateProof, err := stateProofTree.Prove(int(gIndex))
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	proof := make([]common.Root, len(pathInStateProof.Hashes))
+	for i, hash := range pathInStateProof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+	return proof, common.NewRootFromBytes(pathInStateProof.Leaf), nil
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	mlib "github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStatePathProof tests the ProveStatePathInBlock function and that the
+// generated proof correctly verifies for each fork version.
+func TestStatePathProof(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name           string
+		forkVersion    common.Version
+		zeroPubkeyGIdx uint64
+	}{
+		{
+			name:           "Deneb",
+			forkVersion:    version.Deneb(),
+			zeroPubkeyGIdx: merkle.ZeroValidatorPubkeyGIndexDenebBlock,
+		},
+		{
+			name:           "Deneb1",
+			forkVersion:    version.Deneb1(),
+			zeroPubkeyGIdx: merkle.ZeroValidatorPubkeyGIndexDenebBlock,
+		},
+		{
+			name:           "Electra",
+			forkVersion:    version.Electra(),
+			zeroPubkeyGIdx: merkle.ZeroValidatorPubkeyGIndexElectraBlock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			vals := make(types.Validators, 20)
+			for i := range vals {
+				vals[i] = &types.Validator{
+					Pubkey:           [48]byte{byte(i)},
+					EffectiveBalance: math.Gwei(32e9 + i),
+				}
+			}
+			bs := mock.NewBeaconStateWith(
+				7, vals, 0, common.ExecutionAddress{}, tc.forkVersion,
+			)
+			bbh := types.NewBeaconBlockHeader(
+				7, 3, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+			)
+
+			// The pubkey of a validator matches the known generalized indices.
+			proof, err := merkle.ProveStatePathInBlock(
+				bbh, bs, mlib.ObjectPath("validators/12/pubkey"),
+			)
+			require.NoError(t, err)
+			require.Equal(t,
+				tc.zeroPubkeyGIdx+merkle.ValidatorPubkeyGIndexOffset*12, proof.GIndex,
+			)
+			require.Equal(t, bbh.HashTreeRoot(), proof.BeaconBlockRoot)
+
+			// A basic value is found in its leaf chunk at the given offset.
+			proof, err = merkle.ProveStatePathInBlock(
+				bbh, bs, mlib.ObjectPath("validators/12/effective_balance"),
+			)
+			require.NoError(t, err)
+			require.Equal(t,
+				uint64(32e9+12),
+				binary.LittleEndian.Uint64(proof.Leaf[proof.Offset:]),
+			)
+			require.True(t, mlib.VerifyProof(
+				proof.BeaconBlockRoot, proof.Leaf, proof.GIndex, proof.Proof,
+			))
+
+			// Top level fields can be proven too.
+			proof, err = merkle.ProveStatePathInBlock(
+				bbh, bs, mlib.ObjectPath("slot"),
+			)
+			require.NoError(t, err)
+			require.Equal(t, uint64(7), binary.LittleEndian.Uint64(proof.Leaf[:]))
+		})
+	}
+}
+
+// TestStatePathProofInvalidPath tests that paths that cannot be resolved in
+// the beacon state are rejected.
+func TestStatePathProofInvalidPath(t *testing.T) {
+	t.Parallel()
+	bs := mock.NewBeaconStateWith(
+		7, types.Validators{&types.Validator{}}, 0, common.ExecutionAddress{}, version.Deneb(),
+	)
+	bbh := types.NewBeaconBlockHeader(
+		7, 0, common.Root{}, bs.HashTreeRoot(), common.Root{},
+	)
+
+	for _, path := range []string{
+		"unknown_field",
+		"slot/0",
+		"validators/foo",
+		"validators/5/pubkey",
+		"pending_partial_withdrawals/0",
+	} {
+		_, err := merkle.ProveStatePathInBlock(bbh, bs, mlib.ObjectPath(path))
+		require.ErrorIs(t, err, merkle.ErrInvalidObjectPath, path)
+	}
+}
+
+// TestStatePathsMultiproof tests the ProveStatePathsInBlock function, that the
+// multiproof verifies and that it agrees with the single path proofs.
+func TestStatePathsMultiproof(t *testing.T) {
+	t.Parallel()
+	for _, forkVersion := range []common.Version{version.Deneb(), version.Electra()} {
+		t.Run(forkVersion.String(), func(t *testing.T) {
+			t.Parallel()
+			vals := make(types.Validators, 20)
+			for i := range vals {
+				vals[i] = &types.Validator{
+					Pubkey:                [48]byte{byte(i)},
+					WithdrawalCredentials: types.WithdrawalCredentials{0x01, byte(i)},
+					EffectiveBalance:      math.Gwei(32e9 + i),
+					ExitEpoch:             math.Epoch(100 + i),
+				}
+			}
+			bs := mock.NewBeaconStateWith(
+				7, vals, 0, common.ExecutionAddress{}, forkVersion,
+			)
+			bbh := types.NewBeaconBlockHeader(
+				7, 3, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1},
+			)
+
+			paths := []mlib.ObjectPath{
+				"validators/12/pubkey",
+				"validators/12/withdrawal_credentials",
+				"validators/12/effective_balance",
+				"validators/12/exit_epoch",
+				"validators/3/effective_balance",
+				"slot",
+			}
+			mp, err := merkle.ProveStatePathsInBlock(bbh, bs, paths)
+			require.NoError(t, err)
+			require.Equal(t, bbh.HashTreeRoot(), mp.BeaconBlockRoot)
+			require.True(t, mlib.VerifyMultiproof(
+				mp.BeaconBlockRoot, mp.Leaves, mp.Proof, mp.GIndices,
+			))
+
+			totalSingleProofLen := 0
+			for i, path := range paths {
+				single, sErr := merkle.ProveStatePathInBlock(bbh, bs, path)
+				require.NoError(t, sErr)
+				require.Equal(t, single.GIndex, mp.GIndices[i].Unwrap())
+				require.Equal(t, single.Leaf, mp.Leaves[i])
+				require.Equal(t, single.Offset, mp.Offsets[i])
+				totalSingleProofLen += len(single.Proof)
+			}
+			require.Less(t, len(mp.Proof), totalSingleProofLen)
+
+			_, err = merkle.ProveStatePathsInBlock(
+				bbh, bs, []mlib.ObjectPath{"slot", "slot"},
+			)
+			require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// stateDepthBlock is the depth of the beacon state in the beacon block.
+const stateDepthBlock = 3
+
+// StatePathsMultiproof is a merkle multiproof of the chunks at a set of
+// object paths in the beacon state, verifiable against the beacon block root.
+type StatePathsMultiproof struct {
+	// GIndices are the generalized indices of the leaves in the beacon block.
+	GIndices merkle.GeneralizedIndices
+	// Offsets are the byte offsets of the values within the leaf chunks.
+	Offsets []uint8
+	// Leaves are the 32-byte chunks at the object paths.
+	Leaves []common.Root
+	// Proof is the list of helper hashes, ordered as GetHelperIndices.
+	Proof []common.Root
+	// BeaconBlockRoot is the root the multiproof verifies against.
+	BeaconBlockRoot common.Root
+}
+
+// ProveStatePathsInBlock generates a single multiproof for the chunks at the
+// given object paths in the beacon state. Sharing the helper hashes between
+// the paths keeps the proof much smaller than separate branches. The
+// multiproof is then verified against the beacon block root as a sanity
+// check. It uses the fastssz library to build the proof trees.
+func ProveStatePathsInBlock(
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+	paths []merkle.ObjectPath,
+) (*StatePathsMultiproof, error) {
+	stateSchema, err := GetBeaconStateSchema(bsm.GetForkVersion())
+	if err != nil {
+		return nil, err
+	}
+	stateProofTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	blockProofTree, err := bbh.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	mp := &StatePathsMultiproof{
+		GIndices: make(merkle.GeneralizedIndices, len(paths)),
+		Offsets:  make([]uint8, len(paths)),
+		Leaves:   make([]common.Root, len(paths)),
+	}
+	seen := make(map[merkle.GeneralizedIndex]struct{}, len(paths))
+	for i, path := range paths {
+		_, gIndexState, offset, gErr := path.GetGeneralizedIndex(stateSchema)
+		if gErr != nil {
+			return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: %s", path, gErr.Error())
+		}
+		gIndexBlock := merkle.GeneralizedIndices{
+			StateGIndexBlock, merkle.GeneralizedIndex(gIndexState),
+		}.Concat()
+		if gIndexBlock.Length() >= 63 { //nolint:mnd // int64 bits.
+			return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: path too deep", path)
+		}
+		if _, ok := seen[gIndexBlock]; ok {
+			return nil, errors.Wrapf(ErrInvalidObjectPath, "%s: duplicate path", path)
+		}
+		seen[gIndexBlock] = struct{}{}
+
+		leaf, nErr := nodeInBlock(blockProofTree, stateProofTree, gIndexBlock)
+		if nErr != nil {
+			return nil, errors.Wrapf(
+				ErrInvalidObjectPath, "%s: not present in beacon state", path,
+			)
+		}
+		mp.GIndices[i], mp.Offsets[i], mp.Leaves[i] = gIndexBlock, offset, leaf
+	}
+
+	helperIndices := mp.GIndices.GetHelperIndices()
+	mp.Proof = make([]common.Root, len(helperIndices))
+	for i, helperIndex := range helperIndices {
+		if mp.Proof[i], err = nodeInBlock(
+			blockProofTree, stateProofTree, helperIndex,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sanity check that the multiproof verifies against our beacon root.
+	mp.BeaconBlockRoot = bbh.HashTreeRoot()
+	if !merkle.VerifyMultiproof(mp.BeaconBlockRoot, mp.Leaves, mp.Proof, mp.GIndices) {
+		return nil, errors.Wrapf(
+			errors.New("state paths multiproof failed to verify against beacon root"),
+			"beacon root: 0x%s", mp.BeaconBlockRoot,
+		)
+	}
+
+	return mp, nil
+}
+
+// nodeInBlock returns the hash of the node at the given generalized index in
+// the beacon block, descending into the beacon state tree for nodes below the
+// state root.
+func nodeInBlock(
+	blockProofTree, stateProofTree *fastssz.Node, gIndex merkle.GeneralizedIndex,
+) (common.Root, error) {
+	tree := blockProofTree
+	if depth := gIndex.Length(); depth >= stateDepthBlock &&
+		gIndex>>(depth-stateDepthBlock) == StateGIndexBlock {
+		// Re-root the index at the beacon state.
+		below := depth - stateDepthBlock
+		gIndex = merkle.NewGeneralizedIndex(
+			uint8(below), // #nosec G115 -- depth is at most 63.
+			gIndex.Unwrap()&(1<<below-1),
+		)
+		tree = stateProofTree
+	}
+
+	// #nosec G115 -- depth checked by the caller.
+	node, err := tree.Get(int(gIndex))
+	if err != nil {
+		return common.Root{}, err
+	}
+	return common.NewRootFromBytes(node.Hash()), nil
+}
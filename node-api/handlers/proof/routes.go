@@ -35,5 +35,15 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "bkit/v1/proof/block_proposer/:timestamp_id",
 			Handler: h.GetBlockProposer,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:timestamp_id",
+			Handler: h.GetStateProof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/batch/state/:timestamp_id",
+			Handler: h.GetStateMultiproof,
+		},
 	})
 }
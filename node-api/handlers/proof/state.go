@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	mlib "github.com/berachain/beacon-kit/primitives/merkle"
+)
+
+// GetStateProof returns a merkle proof of the value at the given SSZ object
+// path in the beacon state, e.g. "validators/12/effective_balance", that can
+// be verified against the beacon block root.
+func (h *Handler) GetStateProof(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.StateProofRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(params.TimestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Generating state proof", "slot", slot, "path", params.Path)
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	proof, err := merkle.ProveStatePathInBlock(
+		blockHeader, bsm, mlib.ObjectPath(params.Path),
+	)
+	if errors.Is(err, merkle.ErrInvalidObjectPath) {
+		return nil, errors.Wrap(apitypes.ErrInvalidRequest, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return types.StateProofResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   proof.BeaconBlockRoot,
+		Path:              params.Path,
+		GeneralizedIndex:  proof.GIndex,
+		Leaf:              proof.Leaf,
+		Offset:            proof.Offset,
+		Proof:             proof.Proof,
+	}, nil
+}
+
+// GetStateMultiproof returns a single merkle multiproof of the values at the
+// given SSZ object paths in the beacon state, that can be verified against
+// the beacon block root.
+func (h *Handler) GetStateMultiproof(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.StateMultiproofRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveTimestampID(params.TimestampID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info("Generating state multiproof", "slot", slot, "paths", len(params.Paths))
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]mlib.ObjectPath, len(params.Paths))
+	for i, path := range params.Paths {
+		paths[i] = mlib.ObjectPath(path)
+	}
+	multiproof, err := merkle.ProveStatePathsInBlock(blockHeader, bsm, paths)
+	if errors.Is(err, merkle.ErrInvalidObjectPath) {
+		return nil, errors.Wrap(apitypes.ErrInvalidRequest, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make([]types.StateMultiproofLeaf, len(params.Paths))
+	for i, path := range params.Paths {
+		leaves[i] = types.StateMultiproofLeaf{
+			Path:             path,
+			GeneralizedIndex: multiproof.GIndices[i].Unwrap(),
+			Leaf:             multiproof.Leaves[i],
+			Offset:           multiproof.Offsets[i],
+		}
+	}
+	return types.StateMultiproofResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   multiproof.BeaconBlockRoot,
+		Leaves:            leaves,
+		Proof:             multiproof.Proof,
+	}, nil
+}
@@ -27,3 +27,17 @@ import "github.com/berachain/beacon-kit/node-api/handlers/types"
 type BlockProposerRequest struct {
 	types.TimestampIDRequest
 }
+
+// StateProofRequest is the request for the
+// `/proof/state/{timestamp_id}` endpoint.
+type StateProofRequest struct {
+	types.TimestampIDRequest
+	Path string `query:"path" validate:"required"`
+}
+
+// StateMultiproofRequest is the request for the
+// `/proof/batch/state/{timestamp_id}` endpoint.
+type StateMultiproofRequest struct {
+	types.TimestampIDRequest
+	Paths []string `query:"path" validate:"required,min=1,max=64"`
+}
@@ -49,3 +49,67 @@ type BlockProposerResponse struct {
 	// a Generalized Index of 9 in the Deneb fork.
 	ProposerIndexProof []common.Root `json:"proposer_index_proof"`
 }
+
+// StateProofResponse is the response for the
+// `/proof/state/{timestamp_id}` endpoint.
+type StateProofResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Path is the object path in the beacon state that was proven.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the Generalized Index of the leaf in the beacon
+	// block, to be used when verifying the proof against the beacon block root.
+	GeneralizedIndex uint64 `json:"generalized_index,string"`
+
+	// Leaf is the 32-byte chunk holding the value at the path. Basic values
+	// are packed, so the value starts at Offset within the chunk.
+	Leaf common.Root `json:"leaf"`
+
+	// Offset is the byte offset of the value within Leaf.
+	Offset uint8 `json:"offset,string"`
+
+	// Proof can be verified against the beacon block root using the
+	// GeneralizedIndex.
+	Proof []common.Root `json:"proof"`
+}
+
+// StateMultiproofResponse is the response for the
+// `/proof/batch/state/{timestamp_id}` endpoint.
+type StateMultiproofResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Leaves are the proven leaves, in the order the paths were requested.
+	Leaves []StateMultiproofLeaf `json:"leaves"`
+
+	// Proof is the list of helper hashes of the multiproof, ordered by
+	// decreasing Generalized Index as per the consensus specs. It can be
+	// verified against the beacon block root together with all the Leaves.
+	Proof []common.Root `json:"proof"`
+}
+
+// StateMultiproofLeaf is a single leaf of a StateMultiproofResponse.
+type StateMultiproofLeaf struct {
+	// Path is the object path in the beacon state of this leaf.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the Generalized Index of the leaf in the beacon
+	// block.
+	GeneralizedIndex uint64 `json:"generalized_index,string"`
+
+	// Leaf is the 32-byte chunk holding the value at the path.
+	Leaf common.Root `json:"leaf"`
+
+	// Offset is the byte offset of the value within Leaf.
+	Offset uint8 `json:"offset,string"`
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package handlers
+
+// HeaderConsensusVersion is the header carrying the fork name of versioned
+// request and response bodies.
+const HeaderConsensusVersion = "Eth-Consensus-Version"
+
+// SSZResponse is implemented by responses that can also be served SSZ encoded
+// to clients accepting `application/octet-stream`.
+type SSZResponse interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// VersionedResponse is implemented by responses of fork versioned data. Their
+// fork name is returned in the Eth-Consensus-Version header.
+type VersionedResponse interface {
+	ConsensusVersion() string
+}
+
+// SSZRequest is implemented by requests whose body can also be sent SSZ
+// encoded as `application/octet-stream`. The fork name of the body is taken
+// from the Eth-Consensus-Version header.
+type SSZRequest interface {
+	UnmarshalSSZBody(bz []byte, forkName string) error
+}
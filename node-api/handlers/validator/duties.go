@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetProposerDuties returns the predicted proposers of the slots of the
+// current or the next epoch.
+//
+// NOTE: beacon-kit does not select proposers with RANDAO. Proposers are
+// predicted from the CometBFT validator set, assuming every slot is decided in
+// the first round. Round changes and validator set updates shift the actual
+// schedule, so the duties are best effort.
+func (h *Handler) GetProposerDuties(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetProposerDutiesRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := math.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	duties, err := h.backend.ProposerDuties(epoch)
+	if err != nil {
+		return nil, err
+	}
+	// The schedule depends on the validator set of the head block.
+	dependentRoot, err := h.backend.BlockRootAtSlot(utils.Head)
+	if err != nil {
+		return nil, err
+	}
+	return types.ProposerDutiesResponse{
+		DependentRoot:       dependentRoot,
+		ExecutionOptimistic: false,
+		Data:                duties,
+	}, nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// PostLiveness returns whether the requested validators were live in the given
+// epoch, i.e. whether they signed at least one of the blocks of the epoch.
+//
+// NOTE: liveness is derived from the missed block signatures reported by
+// CometBFT, which are only retained for the epochs of the liveness window.
+func (h *Handler) PostLiveness(c handlers.Context) (any, error) {
+	var indices []string
+	if err := c.Bind(&indices); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	req := validatortypes.PostLivenessRequest{
+		Epoch:   c.Param("epoch"),
+		Indices: indices,
+	}
+	if err := c.Validate(&req); err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	epoch, err := math.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	valIndices := make([]math.ValidatorIndex, len(req.Indices))
+	for i, index := range req.Indices {
+		if valIndices[i], err = math.U64FromString(index); err != nil {
+			return nil, err
+		}
+	}
+
+	liveness, err := h.backend.ValidatorsLiveness(epoch, valIndices)
+	if err != nil {
+		return nil, err
+	}
+	return validatortypes.LivenessResponse{Data: liveness}, nil
+}
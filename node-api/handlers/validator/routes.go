@@ -38,7 +38,7 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/validator/duties/proposer/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.GetProposerDuties,
 		},
 		{
 			Method:  http.MethodPost,
@@ -113,7 +113,7 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/validator/liveness/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.PostLiveness,
 		},
 	})
 }
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
@@ -82,6 +83,27 @@ func DefaultServiceOptions(
 	}
 }
 
+// SnapshotServiceOption returns the Service option that takes and serves
+// state-sync snapshots of the application state, with the given extensions.
+func SnapshotServiceOption(
+	appOpts config.AppOptions,
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) func(*cometbft.Service) {
+	snapshotStore, err := server.GetSnapshotStore(
+		cast.ToString(appOpts.Get(flags.FlagHome)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	snapshotOpts, err := server.GetSnapshotOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	return cometbft.SetSnapshot(snapshotStore, snapshotOpts, extensions...)
+}
+
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
 	var (
 		homeDir = cast.ToString(appOpts.Get(flags.FlagHome))
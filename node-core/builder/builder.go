@@ -24,6 +24,7 @@ import (
 	"io"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
@@ -66,9 +67,10 @@ func (nb *NodeBuilder) Build(
 		apiBackend interface {
 			AttachQueryBackend(types.ConsensusService)
 		}
-		beaconNode types.Node
-		cmtService types.ConsensusService
-		config     *config.Config
+		beaconNode   types.Node
+		chainService *blockchain.Service
+		cmtService   types.ConsensusService
+		config       *config.Config
 	)
 
 	chainSpec, err := servertypes.CreateChainSpec(appOpts)
@@ -92,6 +94,7 @@ func (nb *NodeBuilder) Build(
 		),
 		&apiBackend,
 		&beaconNode,
+		&chainService,
 		&cmtService,
 		&config,
 	); err != nil {
@@ -103,8 +106,12 @@ func (nb *NodeBuilder) Build(
 	if apiBackend == nil {
 		panic("node or api backend is nil")
 	}
+	if chainService == nil {
+		panic("chain service is nil")
+	}
 
 	logger.WithConfig(config.GetLogger())
 	apiBackend.AttachQueryBackend(cmtService)
+	chainService.AttachStateHistory(cmtService)
 	return beaconNode
 }
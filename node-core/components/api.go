@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/backend"
@@ -45,6 +46,7 @@ type NodeAPIBackendInput struct {
 	ChainSpec      chain.Spec
 	StorageBackend *storage.Backend
 	CometConfig    *cmtcfg.Config
+	EngineClient   *client.EngineClient
 }
 
 func ProvideNodeAPIBackend(
@@ -54,6 +56,7 @@ func ProvideNodeAPIBackend(
 		in.StorageBackend,
 		in.ChainSpec,
 		in.CometConfig,
+		in.EngineClient,
 	)
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	"github.com/berachain/beacon-kit/storage/block"
 	depositdb "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/exitpool"
 )
 
 // StorageBackendInput is the input for the ProvideStorageBackend function.
@@ -39,6 +40,7 @@ type StorageBackendInput struct {
 	ChainSpec         chain.Spec
 	DepositStore      *depositdb.KVStore
 	BeaconStore       *beacondb.KVStore
+	SignedBlockStore  *block.SignedStore
 }
 
 // ProvideStorageBackend is the depinject provider that returns a beacon storage
@@ -52,5 +54,7 @@ func ProvideStorageBackend(
 		in.BeaconStore,
 		in.DepositStore,
 		in.BlockStore,
+		in.SignedBlockStore,
+		exitpool.New(),
 	)
 }
@@ -21,26 +21,88 @@
 package components
 
 import (
+	"path/filepath"
+
 	"cosmossdk.io/depinject"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/storage/block"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // BlockStoreInput is the input for the dep inject framework.
 type BlockStoreInput struct {
 	depinject.In
 
-	Config *config.Config
-	Logger *phuslu.Logger
+	AppOpts config.AppOptions
+	Logger  *phuslu.Logger
 }
 
 // ProvideBlockStore is a function that provides the module to the
-// application.
+// application. The block indices are retained for as long as the app state
+// they refer to is, according to the pruning options of the node.
 func ProvideBlockStore(in BlockStoreInput) (*block.KVStore[*ctypes.BeaconBlock], error) {
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		dataDir = filepath.Join(rootDir, "data")
+		name    = "block_indices"
+	)
+
+	pruningOpts, err := server.GetPruningOptionsFromFlags(in.AppOpts)
+	if err != nil {
+		return nil, err
+	}
+	var retention uint64
+	if pruningOpts.Strategy != pruningtypes.PruningNothing {
+		retention = pruningOpts.KeepRecent
+	}
+
+	pdb, err := dbm.NewDB(name, dbm.PebbleDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
 	return block.NewStore[*ctypes.BeaconBlock](
+		storage.NewKVStoreProvider(pdb),
+		pdb.Close,
+		retention,
 		in.Logger.With("service", "block-store"),
-		in.Config.BlockStoreService.AvailabilityWindow,
+	), nil
+}
+
+// SignedBlockStoreInput is the input for the dep inject framework.
+type SignedBlockStoreInput struct {
+	depinject.In
+
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  *phuslu.Logger
+}
+
+// ProvideSignedBlockStore is a function that provides the disk backed store of
+// full signed beacon blocks to the application.
+func ProvideSignedBlockStore(in SignedBlockStoreInput) (*block.SignedStore, error) {
+	var (
+		rootDir = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		dataDir = filepath.Join(rootDir, "data")
+		name    = "blocks"
+	)
+
+	pdb, err := dbm.NewDB(name, dbm.PebbleDBBackend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.NewSignedStore(
+		storage.NewKVStoreProvider(pdb),
+		pdb.Close,
+		in.Config.BlockStoreService.BlockRetention,
+		in.Logger.With("service", "signed-block-store"),
 	), nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -38,8 +39,10 @@ import (
 type ChainServiceInput struct {
 	depinject.In
 
+	AttributesFactory     AttributesFactory
 	ChainSpec             chain.Spec
 	Cfg                   *config.Config
+	EventBroker           *events.Broker
 	ExecutionEngine       *engine.Engine
 	LocalBuilder          LocalBuilder
 	Logger                *phuslu.Logger
@@ -62,6 +65,8 @@ func ProvideChainService(in ChainServiceInput) *blockchain.Service {
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
+		in.AttributesFactory,
+		in.EventBroker,
 		in.StateProcessor,
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
@@ -23,11 +23,16 @@ package components
 import (
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/snapshot"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -41,14 +46,53 @@ func ProvideCometBFTService(
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
 	telemetrySink *metrics.TelemetrySink,
+	chainSpec chain.Spec,
+	depositStore *depositstore.KVStore,
+	availabilityStore *dastore.Store,
+	storageBackend *storage.Backend,
 ) *cometbft.Service {
-	return cometbft.NewService(
+	// Snapshots carry the deposit and blob stores along with the multistore,
+	// so that a node restored from one can serve deposits and blobs.
+	stateReader := &snapshotStateReader{storageBackend: storageBackend}
+	snapshotExtension := snapshot.NewExtension(
+		depositStore,
+		availabilityStore,
+		stateReader,
+		chainSpec,
+		logger.With("service", "snapshot"),
+	)
+
+	service := cometbft.NewService(
 		logger,
 		db,
 		blockchain,
 		blockBuilder,
 		cmtCfg,
 		telemetrySink,
-		builder.DefaultServiceOptions(appOpts)...,
+		append(
+			builder.DefaultServiceOptions(appOpts),
+			builder.SnapshotServiceOption(appOpts, snapshotExtension),
+			cometbft.SetStorageBackend(storageBackend, chainSpec),
+		)...,
 	)
+	stateReader.service = service
+	return service
+}
+
+// snapshotStateReader reads the beacon states committed by the CometBFT
+// service for the snapshot extension. The service is set once created, as it
+// takes the extension as an option.
+type snapshotStateReader struct {
+	service        *cometbft.Service
+	storageBackend *storage.Backend
+}
+
+// Eth1DepositIndexAt returns the eth1 deposit index of the beacon state
+// committed at the given height.
+func (r *snapshotStateReader) Eth1DepositIndexAt(height uint64) (uint64, error) {
+	queryCtx, err := r.service.CreateQueryContext(int64(height), false) // #nosec G115 -- not an issue in practice.
+	if err != nil {
+		return 0, err
+	}
+	return r.storageBackend.StateFromContext(queryCtx).GetEth1DepositIndex()
 }
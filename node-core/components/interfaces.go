@@ -31,6 +31,8 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	nodeapitypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	validatorapitypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
 	nodecoretypes "github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -129,6 +131,7 @@ type (
 			ctypes.Deposits,
 			*ctypes.ExecutionPayloadHeader,
 			common.Version,
+			*ctypes.GenesisRestart,
 		) (transition.ValidatorUpdates, error)
 		// ProcessFork prepares the state for the fork version at the given timestamp.
 		ProcessFork(
@@ -144,10 +147,22 @@ type (
 			st *statedb.StateDB,
 			blk *ctypes.BeaconBlock,
 		) (transition.ValidatorUpdates, error)
+		// MeterGas records the gas used by the execution payload of the
+		// block.
+		MeterGas(blk *ctypes.BeaconBlock)
 		GetSignatureVerifierFn(st *statedb.StateDB) (
 			func(blk *ctypes.BeaconBlock, signature crypto.BLSSignature) error,
 			error,
 		)
+		// VerifyVoluntaryExit verifies that the signed voluntary exit can be
+		// applied to the given state.
+		VerifyVoluntaryExit(
+			st *statedb.StateDB, exit *ctypes.SignedVoluntaryExit,
+		) error
+		// ValidateStatelessPayload performs the checks on the execution
+		// payload of a block which require neither the state nor the
+		// execution engine.
+		ValidateStatelessPayload(blk *ctypes.BeaconBlock) error
 	}
 
 	SidecarFactory interface {
@@ -163,6 +178,7 @@ type (
 	StorageBackend interface {
 		AvailabilityStore() *dastore.Store
 		BlockStore() *block.KVStore[*ctypes.BeaconBlock]
+		SignedBlockStore() *block.SignedStore
 		DepositStore() *depositdb.KVStore
 		// StateFromContext retrieves the beacon state from the given context.
 		StateFromContext(context.Context) *statedb.StateDB
@@ -512,6 +528,8 @@ type (
 		NodeAPIBeaconBackend
 		NodeAPIProofBackend
 		NodeAPIConfigBackend
+		NodeAPINodeBackend
+		NodeAPIValidatorBackend
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -519,6 +537,7 @@ type (
 		GenesisBackend
 		BlobBackend
 		BlockBackend
+		PoolBackend
 		RandaoBackend
 		StateBackend
 		ValidatorBackend
@@ -531,6 +550,25 @@ type (
 	// NodeAPIConfigBackend is the interface for backend of the config API.
 	NodeAPIConfigBackend interface {
 		Spec() (chain.Spec, error)
+		ForkSchedule() ([]*ctypes.Fork, error)
+	}
+
+	// NodeAPINodeBackend is the interface for backend of the node API.
+	NodeAPINodeBackend interface {
+		NodeIdentity() (*nodeapitypes.IdentityData, error)
+		NodePeers() []*nodeapitypes.PeerData
+		SyncingStatus() (*nodeapitypes.SyncingData, error)
+		NodeVersion() string
+	}
+
+	// NodeAPIValidatorBackend is the interface for backend of the validator
+	// API.
+	NodeAPIValidatorBackend interface {
+		BlockRootAtSlot(slot math.Slot) (common.Root, error)
+		ProposerDuties(epoch math.Epoch) ([]*validatorapitypes.ProposerDutyData, error)
+		ValidatorsLiveness(
+			epoch math.Epoch, indices []math.ValidatorIndex,
+		) ([]*validatorapitypes.ValidatorLivenessData, error)
 	}
 
 	// NodeAPIProofBackend is the interface for backend of the proof API.
@@ -551,17 +589,26 @@ type (
 	}
 
 	BlobBackend interface {
-		BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*types.Sidecar, error)
+		BlobSidecarsByIndices(slot math.Slot, indices []uint64) (datypes.BlobSidecars, error)
 	}
 
 	BlockBackend interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+		SignedBlockHeaderAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlockHeader, error)
+		SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error)
+		ForkVersionAtSlot(slot math.Slot) (common.Version, error)
+	}
+
+	PoolBackend interface {
+		VoluntaryExits() []*ctypes.SignedVoluntaryExit
+		SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
 	}
 
 	StateBackend interface {
 		StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+		FinalityCheckpointsAtSlot(slot math.Slot) (*types.FinalityCheckpointsData, error)
 	}
 
 	ValidatorBackend interface {
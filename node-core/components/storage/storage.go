@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	"github.com/berachain/beacon-kit/storage/block"
 	depositdb "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/exitpool"
 )
 
 // Backend is a struct that holds the storage backend. It provides a simple
@@ -40,6 +41,8 @@ type Backend struct {
 	kvStore           *beacondb.KVStore
 	depositStore      *depositdb.KVStore
 	blockStore        *block.KVStore[*types.BeaconBlock]
+	signedBlockStore  *block.SignedStore
+	voluntaryExitPool *exitpool.Pool
 }
 
 func NewBackend(
@@ -48,6 +51,8 @@ func NewBackend(
 	kvStore *beacondb.KVStore,
 	depositStore *depositdb.KVStore,
 	blockStore *block.KVStore[*types.BeaconBlock],
+	signedBlockStore *block.SignedStore,
+	voluntaryExitPool *exitpool.Pool,
 ) *Backend {
 	return &Backend{
 		chainSpec:         chainSpec,
@@ -55,6 +60,8 @@ func NewBackend(
 		kvStore:           kvStore,
 		depositStore:      depositStore,
 		blockStore:        blockStore,
+		signedBlockStore:  signedBlockStore,
+		voluntaryExitPool: voluntaryExitPool,
 	}
 }
 
@@ -79,7 +86,18 @@ func (k Backend) BlockStore() *block.KVStore[*types.BeaconBlock] {
 	return k.blockStore
 }
 
+// SignedBlockStore returns the store of the full signed beacon blocks.
+func (k Backend) SignedBlockStore() *block.SignedStore {
+	return k.signedBlockStore
+}
+
 // DepositStore returns the deposit store struct initialized with a.
 func (k Backend) DepositStore() *depositdb.KVStore {
 	return k.depositStore
 }
+
+// VoluntaryExitPool returns the pool of the signed voluntary exits waiting to
+// be included in a block.
+func (k Backend) VoluntaryExitPool() *exitpool.Pool {
+	return k.voluntaryExitPool
+}
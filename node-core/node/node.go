@@ -32,6 +32,7 @@ import (
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
 	"github.com/berachain/beacon-kit/node-core/types"
@@ -138,3 +139,24 @@ func (n *node) StorageBackend() blockchain.StorageBackend {
 	}
 	return blockchainService.StorageBackend()
 }
+
+// BlockReplayer returns the blockchain service to replay blocks with.
+func (n *node) BlockReplayer() blockchain.BlockReplayer {
+	var blockchainService *blockchain.Service
+	err := n.registry.FetchService(&blockchainService)
+	if err != nil || blockchainService == nil { // appease nilaway
+		err = fmt.Errorf("failed to fetch blockchain service: %w", err)
+		panic(err)
+	}
+	return blockchainService
+}
+
+// StartExecutionClient starts the engine client alone, connecting it to the
+// execution client.
+func (n *node) StartExecutionClient(ctx context.Context) error {
+	var engineClient *client.EngineClient
+	if err := n.registry.FetchService(&engineClient); err != nil {
+		return fmt.Errorf("failed to fetch engine client: %w", err)
+	}
+	return engineClient.Start(ctx)
+}
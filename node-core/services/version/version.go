@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strings"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -37,6 +38,10 @@ import (
 // reported.
 const defaultReportingInterval = 5 * time.Minute
 
+// forkTimeColumns is the width of a fork time line of the console banner,
+// excluding its prefix and trailing border.
+const forkTimeColumns = 70
+
 // ReportingService is a service that periodically logs the running chain
 // version.
 type ReportingService struct {
@@ -150,9 +155,7 @@ func (rs *ReportingService) printToConsole(
 	+ 🧩 Your node is running version: %-40s+
 	+ ♦ Eth client: %-59s+
 	+ 💾 Your system: %-57s+
-	+ 🍴 Deneb1 Fork Time: %-52d+
-	+ 🍴 Electra Fork Time: %-51d+
-	+ 🦺 Please report issues @ https://github.com/berachain/beacon-kit/issues +
+%s	+ 🦺 Please report issues @ https://github.com/berachain/beacon-kit/issues +
 	+==========================================================================+
 
 
@@ -160,11 +163,23 @@ func (rs *ReportingService) printToConsole(
 		rs.version,
 		fmt.Sprintf("%s (version: %s)", ethClient.Name, ethClient.Version),
 		runtime.GOOS+"/"+runtime.GOARCH,
-		rs.forkSpec.Deneb1ForkTime(),
-		rs.forkSpec.ElectraForkTime(),
+		rs.forkTimes(),
 	))
 }
 
+// forkTimes returns a line of the console banner for each fork of the fork
+// registry after the genesis fork.
+func (rs *ReportingService) forkTimes() string {
+	var lines strings.Builder
+	for _, fork := range rs.forkSpec.Forks()[1:] {
+		label := fmt.Sprintf("%s Fork Time: ", fork.Name)
+		lines.WriteString(fmt.Sprintf(
+			"\t+ 🍴 %s%-*d+\n", label, forkTimeColumns-len(label), fork.Timestamp,
+		))
+	}
+	return lines.String()
+}
+
 func (rs *ReportingService) GetEthVersion(
 	ctx context.Context) (engineprimitives.ClientVersionV1, error) {
 	ethVersion := engineprimitives.ClientVersionV1{
@@ -26,6 +26,8 @@ import (
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cometbft/cometbft/p2p"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -34,6 +36,7 @@ import (
 type Node interface {
 	CommitMultistoreAccessor
 	StorageBackendAccessor
+	BlockReplayerAccessor
 
 	Start(context.Context) error
 }
@@ -50,6 +53,16 @@ type StorageBackendAccessor interface {
 	StorageBackend() blockchain.StorageBackend
 }
 
+// BlockReplayerAccessor allows access to the block replayer and to the
+// execution client it may verify payloads with.
+// This is required by commands like replay.
+type BlockReplayerAccessor interface {
+	BlockReplayer() blockchain.BlockReplayer
+	// StartExecutionClient connects to the execution client, which is
+	// otherwise only connected once the node is started.
+	StartExecutionClient(ctx context.Context) error
+}
+
 // ConsensusService defines everything we utilise externally from CometBFT.
 type ConsensusService interface {
 	service.Basic
@@ -58,4 +71,17 @@ type ConsensusService interface {
 		prove bool,
 	) (sdk.Context, error)
 	LastBlockHeight() int64
+	// NodeInfo returns the p2p info of the node, which is nil until the node
+	// has been started.
+	NodeInfo() p2p.NodeInfo
+	// Peers returns the peers the node is connected to.
+	Peers() []p2p.Peer
+	// IsCatchingUp returns true while the node is syncing blocks from its
+	// peers.
+	IsCatchingUp() bool
+	// MaxPeerHeight returns the highest block height reported by a peer.
+	MaxPeerHeight() int64
+	// ProposerSchedule predicts the pubkeys of the proposers of count heights
+	// from start on.
+	ProposerSchedule(start int64, count int) ([]crypto.BLSPubkey, error)
 }
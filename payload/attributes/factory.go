@@ -66,7 +66,7 @@ func (f *Factory) BuildPayloadAttributes(
 	)
 
 	// Get the expected withdrawals to include in this payload.
-	withdrawals, err := st.ExpectedWithdrawals(timestamp)
+	withdrawals, _, err := st.ExpectedWithdrawals(timestamp)
 	if err != nil {
 		f.logger.Error(
 			"Could not get expected withdrawals to get payload attribute",
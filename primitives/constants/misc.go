@@ -39,6 +39,11 @@ const (
 const (
 	// FirstDepositIndex represents the index of the first deposit in the system, set at genesis.
 	FirstDepositIndex uint64 = 0
+
+	// UnsetDepositRequestsStartIndex is the value of the deposit requests start
+	// index before the first EIP-6110 deposit request is processed.
+	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#misc
+	UnsetDepositRequestsStartIndex = ^uint64(0)
 )
 
 // State list lengths.
@@ -52,4 +57,27 @@ const (
 	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#state-list-lengths
 	// 2**27 (= 134,217,728) pending partial withdrawals
 	PendingPartialWithdrawalsLimit = 134_217_728
+
+	// PendingDepositsLimit is the maximum number of pending deposits.
+	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#state-list-lengths
+	// 2**27 (= 134,217,728) pending deposits
+	PendingDepositsLimit = 134_217_728
+
+	// PendingConsolidationsLimit is the maximum number of pending consolidations.
+	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#state-list-lengths
+	// 2**18 (= 262,144) pending consolidations
+	PendingConsolidationsLimit = 262_144
+)
+
+// Electra withdrawal processing constants.
+const (
+	// FullExitRequestAmount is the amount of a withdrawal request that signals
+	// a full exit of the validator.
+	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#misc
+	FullExitRequestAmount math.Gwei = 0
+
+	// MaxPendingPartialsPerWithdrawalsSweep is the maximum number of pending
+	// partial withdrawals processed in a single payload.
+	// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#withdrawals-processing
+	MaxPendingPartialsPerWithdrawalsSweep = 8
 )
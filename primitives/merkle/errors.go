@@ -66,4 +66,20 @@ var (
 	ErrLeavesExceedsLimit = errors.New(
 		"number of leaves exceeds the maximum allowed",
 	)
+
+	// ErrLeavesIndicesMismatch is returned when the number of leaves of a
+	// multiproof does not match the number of generalized indices.
+	ErrLeavesIndicesMismatch = errors.New(
+		"number of leaves does not match number of indices",
+	)
+
+	// ErrProofHelpersMismatch is returned when the number of hashes of a
+	// multiproof does not match the number of helper indices.
+	ErrProofHelpersMismatch = errors.New(
+		"number of proof hashes does not match number of helper indices",
+	)
+
+	// ErrMultiproofIncomplete is returned when the leaves and hashes of a
+	// multiproof are not sufficient to compute the root.
+	ErrMultiproofIncomplete = errors.New("multiproof does not reach the root")
 )
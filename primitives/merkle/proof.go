@@ -20,7 +20,11 @@
 
 package merkle
 
-import "github.com/berachain/beacon-kit/primitives/crypto/sha256"
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+)
 
 // VerifyProof given a tree root, a leaf, the generalized merkle index
 // of the leaf in the tree, and the proof itself.
@@ -91,3 +95,72 @@ func RootFromBranch[RootT, BranchT ~[32]byte](
 	}
 	return merkleRoot
 }
+
+// VerifyMultiproof given a tree root, the leaves, their generalized merkle
+// indices in the tree and the multiproof hashes ordered as returned by
+// GetHelperIndices.
+func VerifyMultiproof[RootT, ProofT ~[32]byte](
+	root RootT,
+	leaves []RootT,
+	proof []ProofT,
+	indices GeneralizedIndices,
+) bool {
+	multiRoot, err := CalculateMultiMerkleRoot(leaves, proof, indices)
+	if err != nil {
+		return false
+	}
+	return multiRoot == root
+}
+
+// CalculateMultiMerkleRoot calculates the Merkle root from a set of leaves at
+// the given generalized indices and the multiproof hashes, as per the
+// Ethereum 2.0 spec:
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/merkle-proofs.md#merkle-multiproofs
+func CalculateMultiMerkleRoot[RootT, ProofT ~[32]byte](
+	leaves []RootT,
+	proof []ProofT,
+	indices GeneralizedIndices,
+) (RootT, error) {
+	if len(leaves) != len(indices) {
+		return RootT{}, ErrLeavesIndicesMismatch
+	}
+	helperIndices := indices.GetHelperIndices()
+	if len(proof) != len(helperIndices) {
+		return RootT{}, ErrProofHelpersMismatch
+	}
+
+	objects := make(map[GeneralizedIndex]RootT, len(indices)+len(helperIndices))
+	for i, index := range indices {
+		objects[index] = leaves[i]
+	}
+	for i, index := range helperIndices {
+		objects[index] = RootT(proof[i])
+	}
+
+	keys := make(GeneralizedIndices, 0, len(objects))
+	for index := range objects {
+		keys = append(keys, index)
+	}
+	slices.SortFunc(keys, GeneralizedIndexReverseComparator)
+
+	var hashInput [64]byte
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		_, hasSibling := objects[k.Sibling()]
+		_, hasParent := objects[k.Parent()]
+		if k <= 1 || !hasSibling || hasParent {
+			continue
+		}
+		left, right := objects[k&^1], objects[k|1]
+		copy(hashInput[:32], left[:])
+		copy(hashInput[32:], right[:])
+		objects[k.Parent()] = sha256.Hash(hashInput[:])
+		keys = append(keys, k.Parent())
+	}
+
+	root, ok := objects[1]
+	if !ok {
+		return RootT{}, ErrMultiproofIncomplete
+	}
+	return root, nil
+}
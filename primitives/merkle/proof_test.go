@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto/sha256"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// buildTestTree returns the nodes of a complete tree with 2^depth leaves,
+// indexed by generalized index.
+func buildTestTree(depth uint8) []common.Root {
+	nodes := make([]common.Root, 2<<depth)
+	for i := 1 << depth; i < len(nodes); i++ {
+		nodes[i] = common.Root{byte(i), byte(i >> 8), 0xaa}
+	}
+	for i := (1 << depth) - 1; i > 0; i-- {
+		nodes[i] = sha256.Hash(append(nodes[2*i][:], nodes[2*i+1][:]...))
+	}
+	return nodes
+}
+
+func TestMultiproof(t *testing.T) {
+	t.Parallel()
+	nodes := buildTestTree(4)
+	root := nodes[1]
+
+	tests := []struct {
+		name    string
+		indices merkle.GeneralizedIndices
+	}{
+		{name: "Single Leaf", indices: merkle.GeneralizedIndices{21}},
+		{name: "Siblings", indices: merkle.GeneralizedIndices{16, 17}},
+		{name: "Disjoint Leaves", indices: merkle.GeneralizedIndices{16, 23, 31}},
+		{name: "Mixed Depths", indices: merkle.GeneralizedIndices{5, 24, 14}},
+		{name: "Root", indices: merkle.GeneralizedIndices{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			leaves := make([]common.Root, len(tt.indices))
+			for i, index := range tt.indices {
+				leaves[i] = nodes[index]
+			}
+			helpers := tt.indices.GetHelperIndices()
+			proof := make([]common.Root, len(helpers))
+			for i, index := range helpers {
+				proof[i] = nodes[index]
+			}
+
+			require.True(t, merkle.VerifyMultiproof(root, leaves, proof, tt.indices))
+
+			// A single leaf multiproof is equivalent to a merkle branch.
+			if len(tt.indices) == 1 && tt.indices[0] > 1 {
+				require.True(t, merkle.VerifyProof(
+					root, leaves[0], tt.indices[0].Unwrap(), proof,
+				))
+			}
+
+			// Tampering with a leaf invalidates the proof.
+			leaves[0][31] ^= 1
+			require.False(t, merkle.VerifyMultiproof(root, leaves, proof, tt.indices))
+		})
+	}
+}
+
+func TestMultiproofInvalidInput(t *testing.T) {
+	t.Parallel()
+	nodes := buildTestTree(3)
+	indices := merkle.GeneralizedIndices{8, 13}
+
+	_, err := merkle.CalculateMultiMerkleRoot(
+		[]common.Root{nodes[8]}, []common.Root{}, indices,
+	)
+	require.ErrorIs(t, err, merkle.ErrLeavesIndicesMismatch)
+
+	_, err = merkle.CalculateMultiMerkleRoot(
+		[]common.Root{nodes[8], nodes[13]}, []common.Root{nodes[9]}, indices,
+	)
+	require.ErrorIs(t, err, merkle.ErrProofHelpersMismatch)
+
+	_, err = merkle.CalculateMultiMerkleRoot(
+		[]common.Root{}, []common.Root{}, merkle.GeneralizedIndices{},
+	)
+	require.ErrorIs(t, err, merkle.ErrMultiproofIncomplete)
+}
@@ -37,6 +37,12 @@ type Context struct {
 	consensusTime math.U64
 	// Address of current block proposer
 	proposerAddress []byte
+	// misbehaviors reported by consensus for the current block, which
+	// must be slashed by the state transition.
+	misbehaviors []Misbehavior
+	// lastCommitVotes are the votes of the validators on the previous
+	// block, used to track validators liveness.
+	lastCommitVotes []Vote
 
 	// verifyPayload indicates whether to call NewPayload on the
 	// execution client. This can be done when the node is not
@@ -96,6 +102,16 @@ func (c *Context) WithVerifyResult(verifyResult bool) *Context {
 	return c
 }
 
+func (c *Context) WithMisbehaviors(misbehaviors []Misbehavior) *Context {
+	c.misbehaviors = misbehaviors
+	return c
+}
+
+func (c *Context) WithLastCommitVotes(votes []Vote) *Context {
+	c.lastCommitVotes = votes
+	return c
+}
+
 // Getters of context attributes.
 func (c *Context) ConsensusCtx() context.Context {
 	return c.consensusCtx
@@ -109,6 +125,14 @@ func (c *Context) ProposerAddress() []byte {
 	return c.proposerAddress
 }
 
+func (c *Context) Misbehaviors() []Misbehavior {
+	return c.misbehaviors
+}
+
+func (c *Context) LastCommitVotes() []Vote {
+	return c.lastCommitVotes
+}
+
 func (c *Context) VerifyPayload() bool {
 	return c.verifyPayload
 }
@@ -43,3 +43,15 @@ func Name(v common.Version) string {
 		return "unknown"
 	}
 }
+
+// FromName returns the fork version with the given name, as returned by Name.
+func FromName(name string) (common.Version, bool) {
+	for _, v := range []common.Version{
+		phase0, altair, bellatrix, capella, deneb, deneb1, electra,
+	} {
+		if Name(v) == name {
+			return v, true
+		}
+	}
+	return common.Version{}, false
+}
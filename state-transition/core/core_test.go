@@ -48,7 +48,6 @@ func setupChain(t *testing.T) chain.Spec {
 	return chainSpec
 }
 
-//nolint:unused // may be used in the future.
 func progressStateToSlot(
 	t *testing.T,
 	beaconState *statetransition.TestBeaconStateT,
@@ -80,6 +79,24 @@ func buildNextBlock(
 	timestamp math.U64,
 	blockDeposits types.Deposits,
 	withdrawals ...*engineprimitives.Withdrawal,
+) *types.BeaconBlock {
+	t.Helper()
+	return buildNextBlockWithRequests(
+		t, cs, beaconState, eth1Data, timestamp, blockDeposits, &types.ExecutionRequests{}, withdrawals...,
+	)
+}
+
+// buildNextBlockWithRequests is buildNextBlock with the given execution
+// requests set on Electra blocks.
+func buildNextBlockWithRequests(
+	t *testing.T,
+	cs chain.Spec,
+	beaconState *statetransition.TestBeaconStateT,
+	eth1Data *types.Eth1Data,
+	timestamp math.U64,
+	blockDeposits types.Deposits,
+	executionRequests *types.ExecutionRequests,
+	withdrawals ...*engineprimitives.Withdrawal,
 ) *types.BeaconBlock {
 	t.Helper()
 	require.NotNil(t, cs)
@@ -111,12 +128,6 @@ func buildNextBlock(
 	}
 	parentBeaconBlockRoot := parentBlkHeader.HashTreeRoot()
 
-	executionRequests := &types.ExecutionRequests{
-		Deposits:       nil,
-		Withdrawals:    nil,
-		Consolidations: nil,
-	}
-
 	var ethBlk *gethprimitives.Block
 	if version.IsBefore(fv, version.Electra()) {
 		ethBlk, _, err = types.MakeEthBlock(payload, &parentBeaconBlockRoot)
@@ -189,6 +200,10 @@ func moveToEndOfEpoch(
 		vals, err := sp.Transition(ctx, st, blk)
 		require.NoError(t, err)
 		require.Empty(t, vals) // no vals changes expected before next epoch
+
+		// processBlockHeader is not run on Transition, so the latest header
+		// is set here for the next block to be built on top of this one.
+		require.NoError(t, st.SetLatestBlockHeader(blk.GetHeader()))
 	}
 	return blk
 }
@@ -27,6 +27,10 @@ var (
 	// exceeds the validator set cap.
 	ErrValSetCapExceeded = errors.New("validator set cap exceeded at genesis")
 
+	// ErrInvalidGenesisRestart is returned when the restart state of an
+	// exported genesis is inconsistent.
+	ErrInvalidGenesisRestart = errors.New("invalid genesis restart state")
+
 	// ErrBlockSlotTooLow is returned when the block slot is too low.
 	ErrBlockSlotTooLow = errors.New("block slot too low")
 
@@ -68,6 +72,15 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrUnexpectedLegacyDeposits is returned when a block includes deposits
+	// from the deposit contract logs after the switch to EIP-6110 deposit
+	// requests has drained them.
+	ErrUnexpectedLegacyDeposits = errors.New("unexpected legacy deposits after deposit requests switch")
+
+	// ErrInvalidVoluntaryExit is returned when a block includes a voluntary
+	// exit that cannot be applied to the state.
+	ErrInvalidVoluntaryExit = errors.New("invalid voluntary exit")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -89,6 +102,18 @@ var (
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
 
+	// ErrExtraDataTooLong is returned when the extra data of an execution
+	// payload exceeds the maximum size.
+	ErrExtraDataTooLong = errors.New("extra data too long")
+
+	// ErrPayloadTimestampBeforeGenesis is returned when an execution payload
+	// is timestamped before the genesis of the chain.
+	ErrPayloadTimestampBeforeGenesis = errors.New("payload timestamp before genesis")
+
+	// ErrInvalidTransactions is returned when the transactions list of an
+	// execution payload exceeds its limits.
+	ErrInvalidTransactions = errors.New("invalid transactions")
+
 	// ErrExceedMaximumWithdrawals is returned when the number of withdrawals
 	// in a block exceeds the maximum allowed.
 	ErrExceedMaximumWithdrawals = errors.New("exceeds maximum withdrawals")
@@ -27,6 +27,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
 )
 
 type ReadOnlyBeaconState interface {
@@ -40,6 +41,8 @@ type ReadOnlyContext interface {
 	ConsensusCtx() context.Context
 	ConsensusTime() math.U64
 	ProposerAddress() []byte
+	Misbehaviors() []transition.Misbehavior
+	LastCommitVotes() []transition.Vote
 	VerifyPayload() bool
 	VerifyRandao() bool
 	VerifyResult() bool
@@ -71,12 +74,21 @@ type ChainSpec interface {
 	chain.ForkSpec
 	chain.DomainTypeSpec
 	chain.WithdrawalsSpec
+	chain.ConsolidationSpec
+	chain.ExitSpec
+	chain.LivenessSpec
+	chain.ProposerRewardSpec
 	SlotsPerEpoch() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 	SlotsPerHistoricalRoot() uint64
 	EpochsPerHistoricalVector() uint64
+	EpochsPerSlashingsVector() uint64
+	ProportionalSlashingMultiplier() uint64
+	MinSlashingPenaltyQuotient() uint64
 	GenesisForkVersion() common.Version
+	ActiveFork(timestamp math.U64) chain.ForkData
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	ValidatorSetCap() uint64
 	HistoricalRootsLimit() uint64
+	MaxBlobCommitmentsPerBlock() uint64
 }
@@ -31,5 +31,6 @@ type ChainSpec interface {
 	SlotToEpoch(slot math.Slot) math.Epoch
 	SlotsPerHistoricalRoot() uint64
 	MaxEffectiveBalance() uint64
+	MinActivationBalance() uint64
 	EpochsPerHistoricalVector() uint64
 }
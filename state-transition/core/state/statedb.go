@@ -26,6 +26,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/storage/beacondb"
@@ -53,6 +54,16 @@ func (s *StateDB) Copy(ctx context.Context) *StateDB {
 	return NewBeaconStateFromDB(s.KVStore.Copy(ctx), s.cs)
 }
 
+// Snapshot returns a copy of the beacon state detached from the underlying
+// multistore, see KVStore.Snapshot.
+func (s *StateDB) Snapshot() (*StateDB, error) {
+	kv, err := s.KVStore.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return NewBeaconStateFromDB(kv, s.cs), nil
+}
+
 // IncreaseBalance increases the balance of a validator.
 func (s *StateDB) IncreaseBalance(idx math.ValidatorIndex, delta math.Gwei) error {
 	balance, err := s.GetBalance(idx)
@@ -72,20 +83,28 @@ func (s *StateDB) DecreaseBalance(idx math.ValidatorIndex, delta math.Gwei) erro
 }
 
 // ExpectedWithdrawals as defined in the Ethereum 2.0 Specification:
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-get_expected_withdrawals
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-get_expected_withdrawals
 //
 // NOTE: This function is modified from the spec to allow a fixed withdrawal
-// (as the first withdrawal) used for EVM inflation.
-func (s *StateDB) ExpectedWithdrawals(timestamp math.U64) (engineprimitives.Withdrawals, error) {
+// (as the first withdrawal) used for EVM inflation. From Electra onwards,
+// pending partial withdrawals are dequeued before the validator sweep and the
+// number of processed pending partial withdrawals is returned alongside.
+//
+//nolint:funlen,gocognit // mirrors the spec.
+func (s *StateDB) ExpectedWithdrawals(
+	timestamp math.U64,
+) (engineprimitives.Withdrawals, uint64, error) {
 	var (
 		validator         *ctypes.Validator
 		balance           math.Gwei
 		withdrawalAddress common.ExecutionAddress
+
+		processedPartialWithdrawals uint64
 	)
 
 	slot, err := s.GetSlot()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	epoch := s.cs.SlotToEpoch(slot)
 	maxWithdrawals := s.cs.MaxWithdrawalsPerPayload()
@@ -96,38 +115,102 @@ func (s *StateDB) ExpectedWithdrawals(timestamp math.U64) (engineprimitives.With
 
 	withdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	fork, err := s.GetFork()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Process the pending partial withdrawals ahead of the validator sweep.
+	if version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		var pendingPartialWithdrawals []*ctypes.PendingPartialWithdrawal
+		pendingPartialWithdrawals, err = s.GetPendingPartialWithdrawals()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		maxPendingPartials := min(
+			constants.MaxPendingPartialsPerWithdrawalsSweep, maxWithdrawals-1,
+		)
+		minActivationBalance := math.Gwei(s.cs.MinActivationBalance())
+		for _, withdrawal := range pendingPartialWithdrawals {
+			if withdrawal.WithdrawableEpoch > epoch ||
+				uint64(len(withdrawals)-1) == maxPendingPartials {
+				break
+			}
+
+			validator, err = s.ValidatorByIndex(withdrawal.ValidatorIndex)
+			if err != nil {
+				return nil, 0, err
+			}
+			balance, err = s.GetBalance(withdrawal.ValidatorIndex)
+			if err != nil {
+				return nil, 0, err
+			}
+			balance -= min(balance, withdrawnAmount(withdrawals, withdrawal.ValidatorIndex))
+
+			hasSufficientEffectiveBalance := validator.GetEffectiveBalance() >= minActivationBalance
+			hasExcessBalance := balance > minActivationBalance
+			if validator.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) &&
+				hasSufficientEffectiveBalance && hasExcessBalance {
+				withdrawalAddress, err = validator.GetWithdrawalCredentials().ToExecutionAddress()
+				if err != nil {
+					return nil, 0, err
+				}
+
+				withdrawals = append(withdrawals, engineprimitives.NewWithdrawal(
+					math.U64(withdrawalIndex),
+					withdrawal.ValidatorIndex,
+					withdrawalAddress,
+					min(balance-minActivationBalance, withdrawal.Amount),
+				))
+
+				// Increment the withdrawal index to process the next withdrawal.
+				withdrawalIndex++
+			}
+			processedPartialWithdrawals++
+		}
 	}
 
 	validatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	totalValidators, err := s.GetTotalValidators()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	bound := min(totalValidators, s.cs.MaxValidatorsPerWithdrawalsSweep())
 
+	// Skip the sweep if pending partial withdrawals already filled the payload.
+	if uint64(len(withdrawals)) == maxWithdrawals {
+		bound = 0
+	}
+
 	// Iterate through indices to find the next validators to withdraw.
 	for range bound {
 		validator, err = s.ValidatorByIndex(validatorIndex)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		balance, err = s.GetBalance(validatorIndex)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
+		// Discount what has already been withdrawn by pending partial withdrawals.
+		balance -= min(balance, withdrawnAmount(withdrawals, validatorIndex))
+
 		// Set the amount of the withdrawal depending on the balance of the validator.
 		if validator.IsFullyWithdrawable(balance, epoch) {
 			withdrawalAddress, err = validator.GetWithdrawalCredentials().ToExecutionAddress()
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 
 			withdrawals = append(withdrawals, engineprimitives.NewWithdrawal(
@@ -144,7 +227,7 @@ func (s *StateDB) ExpectedWithdrawals(timestamp math.U64) (engineprimitives.With
 		) {
 			withdrawalAddress, err = validator.GetWithdrawalCredentials().ToExecutionAddress()
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 
 			withdrawals = append(withdrawals, engineprimitives.NewWithdrawal(
@@ -167,7 +250,21 @@ func (s *StateDB) ExpectedWithdrawals(timestamp math.U64) (engineprimitives.With
 		validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(totalValidators)
 	}
 
-	return withdrawals, nil
+	return withdrawals, processedPartialWithdrawals, nil
+}
+
+// withdrawnAmount returns the total amount already withdrawn from the given
+// validator by the withdrawals in the list.
+func withdrawnAmount(
+	withdrawals []*engineprimitives.Withdrawal, idx math.ValidatorIndex,
+) math.Gwei {
+	var total math.Gwei
+	for _, w := range withdrawals {
+		if w.GetValidatorIndex() == idx {
+			total += w.GetAmount()
+		}
+	}
+	return total
 }
 
 // EVMInflationWithdrawal returns the withdrawal used for EVM balance inflation.
@@ -299,11 +396,84 @@ func (s *StateDB) GetMarshallable() (*ctypes.BeaconState, error) {
 			return nil, getErr
 		}
 		beaconState.PendingPartialWithdrawals = pendingPartialWithdrawals
+
+		consolidationBalanceToConsume, getErr := s.GetConsolidationBalanceToConsume()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.ConsolidationBalanceToConsume = consolidationBalanceToConsume
+
+		earliestConsolidationEpoch, getErr := s.GetEarliestConsolidationEpoch()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.EarliestConsolidationEpoch = earliestConsolidationEpoch
+
+		pendingConsolidations, getErr := s.GetPendingConsolidations()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.PendingConsolidations = pendingConsolidations
+
+		depositRequestsStartIndex, getErr := s.GetDepositRequestsStartIndex()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.DepositRequestsStartIndex = depositRequestsStartIndex
+
+		exitBalanceToConsume, getErr := s.GetExitBalanceToConsume()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.ExitBalanceToConsume = exitBalanceToConsume
+
+		earliestExitEpoch, getErr := s.GetEarliestExitEpoch()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.EarliestExitEpoch = earliestExitEpoch
+
+		pendingDeposits, getErr := s.GetPendingDeposits()
+		if getErr != nil {
+			return nil, getErr
+		}
+		beaconState.PendingDeposits = pendingDeposits
 	}
 
 	return beaconState, nil
 }
 
+// Eth1DepositIndexLimit returns the index up to which (excluded) deposits are
+// read from the deposit contract logs and included in beacon blocks. From
+// Electra, legacy deposits stop at the index of the first EIP-6110 deposit
+// request, as later deposits are processed from the execution requests.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-process_operations
+func (s *StateDB) Eth1DepositIndexLimit() (uint64, error) {
+	fork, err := s.GetFork()
+	if err != nil {
+		return 0, err
+	}
+	if !version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	}
+	return s.GetDepositRequestsStartIndex()
+}
+
+// LegacyDepositsDrained returns whether every legacy deposit preceding the
+// first EIP-6110 deposit request has been processed. Until they are, deposit
+// requests are queued in the pending deposits.
+func (s *StateDB) LegacyDepositsDrained() (bool, error) {
+	depositIndex, err := s.GetEth1DepositIndex()
+	if err != nil {
+		return false, err
+	}
+	depositIndexLimit, err := s.Eth1DepositIndexLimit()
+	if err != nil {
+		return false, err
+	}
+	return depositIndex >= depositIndexLimit, nil
+}
+
 // HashTreeRoot is the interface for the beacon store.
 func (s *StateDB) HashTreeRoot() common.Root {
 	st, err := s.GetMarshallable()
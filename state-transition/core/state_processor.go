@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
 	depositdb "github.com/berachain/beacon-kit/storage/deposit"
 )
@@ -225,6 +226,39 @@ func (sp *StateProcessor) ProcessBlock(
 	// 	return err
 	// }
 
+	// From Electra onwards the block is applied to the state in spec order:
+	//   - withdrawals pay out and dequeue the pending partial withdrawals;
+	//   - misbehaviors reported by consensus are slashed and missed signatures
+	//     are tracked to eject offline validators;
+	//   - legacy deposits are processed, so that they can be drained ahead of
+	//     the switch to EIP-6110 deposit requests, and signed voluntary exits
+	//     are queued;
+	//   - execution requests are applied last.
+	if version.EqualsOrIsAfter(blk.GetForkVersion(), version.Electra()) {
+		if err := sp.processWithdrawals(st, blk); err != nil {
+			return err
+		}
+		if err := sp.processMisbehaviors(ctx, st); err != nil {
+			return err
+		}
+		if err := sp.processLiveness(ctx, st); err != nil {
+			return err
+		}
+		if err := sp.processOperations(ctx, st, blk); err != nil {
+			return err
+		}
+		if err := sp.processVoluntaryExits(st, blk); err != nil {
+			return err
+		}
+		if err := sp.processExecutionRequests(st, blk); err != nil {
+			return err
+		}
+	}
+
+	if err := sp.processProposerReward(ctx, st, blk); err != nil {
+		return err
+	}
+
 	// If we are skipping validate, we can skip calculating the state
 	// root to save compute.
 	// 
@@ -249,7 +283,9 @@ func (sp *StateProcessor) ProcessBlock(
 }
 
 // processEpoch processes the epoch and ensures it matches the local state. Currently
-// beacon-kit does not enforce rewards, penalties, and slashing for validators.
+// beacon-kit does not enforce attestation rewards and penalties for validators, while
+// slashings are enforced from Electra onwards. Proposers are rewarded per block in
+// ProcessBlock, once the proposer reward fork is active.
 func (sp *StateProcessor) processEpoch(st *state.StateDB) (transition.ValidatorUpdates, error) {
 	slot, err := st.GetSlot()
 	if err != nil {
@@ -270,12 +306,21 @@ func (sp *StateProcessor) processEpoch(st *state.StateDB) (transition.ValidatorU
 	if err = sp.processRegistryUpdates(st); err != nil {
 		return nil, err
 	}
+	if err = sp.processLivenessUpdates(st); err != nil {
+		return nil, err
+	}
+	if err = sp.processSlashings(st); err != nil {
+		return nil, err
+	}
+	if err = sp.processPendingConsolidations(st); err != nil {
+		return nil, err
+	}
 	if err = sp.processEffectiveBalanceUpdates(st); err != nil {
 		return nil, err
 	}
-	// if err = sp.processSlashingsReset(st); err != nil {
-	// 	return nil, err
-	// }
+	if err = sp.processSlashingsReset(st); err != nil {
+		return nil, err
+	}
 	if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,383 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processConsolidationRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_consolidation_request
+//
+// NOTE: Modified from the Ethereum 2.0 specification as beacon-kit has no
+// committees nor exit churn:
+// 1. There is no SHARD_COMMITTEE_PERIOD before an active validator can be
+// consolidated.
+// 2. The consolidated source is withdrawable the epoch after its exit epoch.
+//
+// Invalid requests are ignored, as they have already been paid for on the
+// execution layer.
+func (sp *StateProcessor) processConsolidationRequest(
+	st *state.StateDB, req *ctypes.ConsolidationRequest,
+) error {
+	isSwitch, err := sp.isValidSwitchToCompoundingRequest(st, req)
+	if err != nil {
+		return err
+	}
+	if isSwitch {
+		sourceIdx, idxErr := st.ValidatorIndexByPubkey(req.SourcePubKey)
+		if idxErr != nil {
+			return idxErr
+		}
+		return sp.switchToCompoundingValidator(st, sourceIdx)
+	}
+
+	// Verify that source != target, so a consolidation cannot be used as an exit.
+	if req.SourcePubKey == req.TargetPubKey {
+		return nil
+	}
+
+	// If the pending consolidations queue is full, consolidation requests are ignored.
+	pendingConsolidations, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+	if len(pendingConsolidations) == constants.PendingConsolidationsLimit {
+		sp.logger.Info("Ignoring consolidation request, pending consolidations queue is full",
+			"source_pubkey", req.SourcePubKey.String(),
+		)
+		return nil
+	}
+
+	// If there is too little available consolidation churn limit, consolidation requests are ignored.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+	churnLimit, err := sp.getConsolidationChurnLimit(st, currentEpoch)
+	if err != nil {
+		return err
+	}
+	if churnLimit <= math.Gwei(sp.cs.MinActivationBalance()) {
+		sp.logger.Info("Ignoring consolidation request, consolidation churn limit too low",
+			"churn_limit", churnLimit.Unwrap(),
+		)
+		return nil
+	}
+
+	// Verify source and target pubkeys belong to known validators.
+	sourceIdx, err := st.ValidatorIndexByPubkey(req.SourcePubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			sp.logger.Info("Ignoring consolidation request for unknown source validator",
+				"source_pubkey", req.SourcePubKey.String(),
+			)
+			return nil
+		}
+		return err
+	}
+	targetIdx, err := st.ValidatorIndexByPubkey(req.TargetPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			sp.logger.Info("Ignoring consolidation request for unknown target validator",
+				"target_pubkey", req.TargetPubKey.String(),
+			)
+			return nil
+		}
+		return err
+	}
+	source, err := st.ValidatorByIndex(sourceIdx)
+	if err != nil {
+		return err
+	}
+	target, err := st.ValidatorByIndex(targetIdx)
+	if err != nil {
+		return err
+	}
+
+	// Verify source withdrawal credentials.
+	sourceAddress, err := source.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || sourceAddress != req.SourceAddress {
+		sp.logger.Info("Ignoring consolidation request with mismatched source address",
+			"source_index", sourceIdx, "source_address", req.SourceAddress.String(),
+		)
+		return nil
+	}
+
+	// Verify that target has compounding withdrawal credentials.
+	if !target.HasCompoundingWithdrawalCredentials() {
+		sp.logger.Info("Ignoring consolidation request for non-compounding target",
+			"target_index", targetIdx,
+		)
+		return nil
+	}
+
+	// Verify the source and the target are active and have not initiated an exit.
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	if !source.IsActive(currentEpoch) || !target.IsActive(currentEpoch) ||
+		source.GetExitEpoch() != farFutureEpoch || target.GetExitEpoch() != farFutureEpoch {
+		sp.logger.Info("Ignoring consolidation request for inactive or exiting validator",
+			"source_index", sourceIdx, "target_index", targetIdx,
+		)
+		return nil
+	}
+
+	// Verify the source has no pending withdrawals in the queue.
+	pendingPartialWithdrawals, err := st.GetPendingPartialWithdrawals()
+	if err != nil {
+		return err
+	}
+	for _, withdrawal := range pendingPartialWithdrawals {
+		if withdrawal.ValidatorIndex == sourceIdx {
+			sp.logger.Info("Ignoring consolidation request for source with pending withdrawals",
+				"source_index", sourceIdx,
+			)
+			return nil
+		}
+	}
+
+	// Initiate source validator exit and append pending consolidation.
+	exitEpoch, err := sp.computeConsolidationEpochAndUpdateChurn(
+		st, currentEpoch, source.GetEffectiveBalance(),
+	)
+	if err != nil {
+		return err
+	}
+	source.SetExitEpoch(exitEpoch)
+	source.SetWithdrawableEpoch(exitEpoch + 1)
+	if err = st.UpdateValidatorAtIndex(sourceIdx, source); err != nil {
+		return fmt.Errorf("consolidation, failed updating validator idx %d: %w", sourceIdx, err)
+	}
+	pendingConsolidations = append(pendingConsolidations, &ctypes.PendingConsolidation{
+		SourceIndex: sourceIdx,
+		TargetIndex: targetIdx,
+	})
+	if err = st.SetPendingConsolidations(pendingConsolidations); err != nil {
+		return err
+	}
+
+	sp.logger.Info("Processed consolidation request",
+		"source_index", sourceIdx, "target_index", targetIdx, "exit_epoch", exitEpoch,
+	)
+	return nil
+}
+
+// isValidSwitchToCompoundingRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-is_valid_switch_to_compounding_request
+func (sp *StateProcessor) isValidSwitchToCompoundingRequest(
+	st *state.StateDB, req *ctypes.ConsolidationRequest,
+) (bool, error) {
+	// Switch to compounding requires source and target be equal.
+	if req.SourcePubKey != req.TargetPubKey {
+		return false, nil
+	}
+
+	// Verify pubkey exists.
+	idx, err := st.ValidatorIndexByPubkey(req.SourcePubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return false, err
+	}
+
+	// Verify request has been authorized by the 0x01 withdrawal credentials.
+	credentials := val.GetWithdrawalCredentials()
+	if !credentials.IsValidEth1WithdrawalCredentials() {
+		return false, nil
+	}
+	address, err := credentials.ToExecutionAddress()
+	if err != nil || address != req.SourceAddress {
+		return false, nil
+	}
+
+	// Verify the source is active and has not initiated an exit.
+	slot, err := st.GetSlot()
+	if err != nil {
+		return false, err
+	}
+	return val.IsActive(sp.cs.SlotToEpoch(slot)) &&
+		val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch), nil
+}
+
+// switchToCompoundingValidator as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-switch_to_compounding_validator
+//
+// NOTE: Modified from the Ethereum 2.0 specification as beacon-kit already
+// lets 0x01 validators' effective balance grow up to MaxEffectiveBalance, so
+// there is no excess active balance to queue as a pending deposit.
+func (sp *StateProcessor) switchToCompoundingValidator(
+	st *state.StateDB, idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	val.SetWithdrawalCredentials(val.GetWithdrawalCredentials().ToCompounding())
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return fmt.Errorf("switch to compounding, failed updating validator idx %d: %w", idx, err)
+	}
+
+	sp.logger.Info("Switched validator to compounding withdrawal credentials",
+		"validator_index", idx,
+	)
+	return nil
+}
+
+// getConsolidationChurnLimit returns the balance that can be consolidated per
+// epoch. Modified from the Ethereum 2.0 specification as beacon-kit has no
+// activation or exit churn: the whole balance churn is available to
+// consolidations and is driven by dedicated chain spec parameters.
+func (sp *StateProcessor) getConsolidationChurnLimit(
+	st *state.StateDB, currentEpoch math.Epoch,
+) (math.Gwei, error) {
+	totalActiveBalance, err := sp.getTotalActiveBalance(st, currentEpoch)
+	if err != nil {
+		return 0, err
+	}
+
+	increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	churn := max(
+		math.Gwei(sp.cs.MinPerEpochConsolidationChurnLimit()),
+		totalActiveBalance/math.Gwei(sp.cs.ConsolidationChurnLimitQuotient()),
+	)
+	return churn - churn%increment, nil
+}
+
+// computeConsolidationEpochAndUpdateChurn as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-compute_consolidation_epoch_and_update_churn
+//
+// NOTE: exits take effect the next epoch in beacon-kit, which is used in place
+// of compute_activation_exit_epoch.
+func (sp *StateProcessor) computeConsolidationEpochAndUpdateChurn(
+	st *state.StateDB, currentEpoch math.Epoch, consolidationBalance math.Gwei,
+) (math.Epoch, error) {
+	stateEarliestEpoch, err := st.GetEarliestConsolidationEpoch()
+	if err != nil {
+		return 0, err
+	}
+	earliestEpoch := max(stateEarliestEpoch, currentEpoch+1)
+	perEpochChurn, err := sp.getConsolidationChurnLimit(st, currentEpoch)
+	if err != nil {
+		return 0, err
+	}
+
+	// New epoch for consolidations.
+	var balanceToConsume math.Gwei
+	if stateEarliestEpoch < earliestEpoch {
+		balanceToConsume = perEpochChurn
+	} else {
+		balanceToConsume, err = st.GetConsolidationBalanceToConsume()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Consolidation doesn't fit in the current earliest epoch.
+	if consolidationBalance > balanceToConsume {
+		balanceToProcess := consolidationBalance - balanceToConsume
+		additionalEpochs := (balanceToProcess-1)/perEpochChurn + 1
+		earliestEpoch += math.Epoch(additionalEpochs)
+		balanceToConsume += additionalEpochs * perEpochChurn
+	}
+
+	// Consume the balance and update state variables.
+	if err = st.SetConsolidationBalanceToConsume(balanceToConsume - consolidationBalance); err != nil {
+		return 0, err
+	}
+	if err = st.SetEarliestConsolidationEpoch(earliestEpoch); err != nil {
+		return 0, err
+	}
+	return earliestEpoch, nil
+}
+
+// processPendingConsolidations as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_pending_consolidations
+func (sp *StateProcessor) processPendingConsolidations(st *state.StateDB) error {
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if !version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+
+	pendingConsolidations, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+
+	var processed int
+	for _, consolidation := range pendingConsolidations {
+		source, errInLoop := st.ValidatorByIndex(consolidation.SourceIndex)
+		if errInLoop != nil {
+			return errInLoop
+		}
+		if source.IsSlashed() {
+			processed++
+			continue
+		}
+		if source.GetWithdrawableEpoch() > nextEpoch {
+			break
+		}
+
+		// Calculate the consolidated balance and move it from source to target.
+		balance, errInLoop := st.GetBalance(consolidation.SourceIndex)
+		if errInLoop != nil {
+			return errInLoop
+		}
+		consolidatedBalance := min(balance, source.GetEffectiveBalance())
+		if errInLoop = st.DecreaseBalance(consolidation.SourceIndex, consolidatedBalance); errInLoop != nil {
+			return errInLoop
+		}
+		if errInLoop = st.IncreaseBalance(consolidation.TargetIndex, consolidatedBalance); errInLoop != nil {
+			return errInLoop
+		}
+		processed++
+
+		sp.logger.Info("Processed pending consolidation",
+			"source_index", consolidation.SourceIndex,
+			"target_index", consolidation.TargetIndex,
+			"amount", consolidatedBalance.Unwrap(),
+		)
+	}
+
+	return st.SetPendingConsolidations(pendingConsolidations[processed:])
+}
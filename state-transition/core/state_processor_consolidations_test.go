@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionConsolidationRequest shows that an EIP-7251 request switches a
+// validator to compounding credentials, that a consolidation into it exits the
+// source and, once the source is withdrawable, moves its balance to the target.
+func TestTransitionConsolidationRequest(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance   = math.Gwei(cs.MaxEffectiveBalance())
+		increment    = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials0 = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		address1     = common.ExecutionAddress{0x01}
+		credentials1 = types.NewCredentialsFromExecutionAddress(address1)
+		address2     = common.ExecutionAddress{0x02}
+		credentials2 = types.NewCredentialsFromExecutionAddress(address2)
+		val1Balance  = 100 * increment
+		val2Balance  = 200 * increment
+	)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials0,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials1,
+			Amount:      val1Balance,
+			Index:       1,
+		},
+		{
+			Pubkey:      [48]byte{0x02},
+			Credentials: credentials2,
+			Amount:      val2Balance,
+			Index:       2,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	consolidate1Into2 := &types.ConsolidationRequest{
+		SourceAddress: address1,
+		SourcePubKey:  [48]byte{0x01},
+		TargetPubKey:  [48]byte{0x02},
+	}
+	requests := &types.ExecutionRequests{
+		Consolidations: []*types.ConsolidationRequest{
+			// Ignored, as the target does not have compounding credentials yet.
+			consolidate1Into2,
+			// Switch validator 2 to compounding credentials.
+			{
+				SourceAddress: address2,
+				SourcePubKey:  [48]byte{0x02},
+				TargetPubKey:  [48]byte{0x02},
+			},
+			consolidate1Into2,
+		},
+	}
+	blk := buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{},
+		requests, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	val2, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.True(t, val2.HasCompoundingWithdrawalCredentials())
+	require.Equal(t, credentials2.ToCompounding(), val2.GetWithdrawalCredentials())
+
+	val1, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val1.GetExitEpoch())
+	require.Equal(t, math.Epoch(2), val1.GetWithdrawableEpoch())
+
+	pending, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, []*types.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 2},
+	}, pending)
+
+	// The consolidation churn of the exit epoch is consumed.
+	earliestEpoch, err := st.GetEarliestConsolidationEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), earliestEpoch)
+	balanceToConsume, err := st.GetConsolidationBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(cs.MinPerEpochConsolidationChurnLimit())-val1Balance, balanceToConsume)
+
+	// The pending consolidation is processed at the epoch the source becomes
+	// withdrawable.
+	progressStateToSlot(t, st, math.Slot(2*cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(11)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	val1BalAfter, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Zero(t, val1BalAfter)
+	val2BalAfter, err := st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, val1Balance+val2Balance, val2BalAfter)
+
+	pending, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// processExecutionRequests applies the EIP-7685 execution requests carried by
+// an Electra block to the state.
+func (sp *StateProcessor) processExecutionRequests(
+	st *state.StateDB, blk *ctypes.BeaconBlock,
+) error {
+	requests, err := blk.GetBody().GetExecutionRequests()
+	if err != nil {
+		return err
+	}
+	sp.logger.Info(
+		"Processing execution requests",
+		"deposits", len(requests.Deposits),
+		"withdrawals", len(requests.Withdrawals),
+		"consolidations", len(requests.Consolidations),
+	)
+
+	for _, deposit := range requests.Deposits {
+		if err = sp.processDepositRequest(st, deposit); err != nil {
+			return err
+		}
+	}
+	if err = sp.processPendingDeposits(st); err != nil {
+		return err
+	}
+	for _, withdrawal := range requests.Withdrawals {
+		if err = sp.processWithdrawalRequest(st, withdrawal); err != nil {
+			return err
+		}
+	}
+	for _, consolidation := range requests.Consolidations {
+		if err = sp.processConsolidationRequest(st, consolidation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_deposit_request
+//
+// The deposit request is queued in the pending deposits, so that it is only
+// applied after the legacy deposits preceding it.
+func (sp *StateProcessor) processDepositRequest(
+	st *state.StateDB, req *ctypes.DepositRequest,
+) error {
+	// Set deposit request start index. Legacy deposits from this index onwards
+	// are no longer included in beacon blocks.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		if err = st.SetDepositRequestsStartIndex(req.GetIndex().Unwrap()); err != nil {
+			return err
+		}
+		sp.logger.Info("Switched to EIP-6110 deposit requests",
+			"deposit_requests_start_index", req.GetIndex().Unwrap(),
+		)
+	}
+
+	pendingDeposits, err := st.GetPendingDeposits()
+	if err != nil {
+		return err
+	}
+	return st.SetPendingDeposits(append(pendingDeposits, req))
+}
+
+// processPendingDeposits as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_pending_deposits
+//
+// NOTE: Modified from the Ethereum 2.0 specification as beacon-kit has no
+// activation churn: once every legacy deposit preceding the first deposit
+// request is processed, the pending deposits are all applied in order, as
+// legacy deposits from the deposit contract logs would be. This runs with the
+// execution requests of every block rather than at epoch processing.
+func (sp *StateProcessor) processPendingDeposits(st *state.StateDB) error {
+	drained, err := st.LegacyDepositsDrained()
+	if err != nil {
+		return err
+	}
+	if !drained {
+		return nil
+	}
+
+	pendingDeposits, err := st.GetPendingDeposits()
+	if err != nil {
+		return err
+	}
+	if len(pendingDeposits) == 0 {
+		return nil
+	}
+	for _, deposit := range pendingDeposits {
+		if err = sp.applyDeposit(st, deposit); err != nil {
+			return fmt.Errorf("failed to apply deposit request: %w", err)
+		}
+	}
+	return st.SetPendingDeposits([]*ctypes.Deposit{})
+}
+
+// processWithdrawalRequest as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+// NOTE: Modified from the Ethereum 2.0 specification as beacon-kit has no
+// committees:
+// 1. There is no SHARD_COMMITTEE_PERIOD before an active validator can exit.
+// 2. Partial withdrawals are allowed for execution (0x01) credentials, since
+// validators' effective balance may grow up to MaxEffectiveBalance.
+//
+// Invalid requests are ignored, as they have already been paid for on the
+// execution layer.
+func (sp *StateProcessor) processWithdrawalRequest(
+	st *state.StateDB, req *ctypes.WithdrawalRequest,
+) error {
+	isFullExitRequest := req.Amount == constants.FullExitRequestAmount
+
+	pendingPartialWithdrawals, err := st.GetPendingPartialWithdrawals()
+	if err != nil {
+		return err
+	}
+
+	// If the partial withdrawal queue is full, only full exits are processed.
+	if len(pendingPartialWithdrawals) == constants.PendingPartialWithdrawalsLimit &&
+		!isFullExitRequest {
+		sp.logger.Info("Ignoring withdrawal request, pending partial withdrawals queue is full",
+			"pubkey", req.ValidatorPubKey.String(),
+		)
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			sp.logger.Info("Ignoring withdrawal request for unknown validator",
+				"pubkey", req.ValidatorPubKey.String(),
+			)
+			return nil
+		}
+		return err
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Verify withdrawal credentials.
+	withdrawalAddress, err := val.GetWithdrawalCredentials().ToExecutionAddress()
+	if err != nil || withdrawalAddress != req.SourceAddress {
+		sp.logger.Info("Ignoring withdrawal request with mismatched source address",
+			"validator_index", idx, "source_address", req.SourceAddress.String(),
+		)
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+
+	// Verify the validator is active and has not initiated an exit.
+	if !val.IsActive(currentEpoch) ||
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		sp.logger.Info("Ignoring withdrawal request for inactive or exiting validator",
+			"validator_index", idx,
+		)
+		return nil
+	}
+
+	pendingBalance := pendingBalanceToWithdraw(pendingPartialWithdrawals, idx)
+
+	if isFullExitRequest {
+		// Only exit the validator if it has no pending withdrawals in the queue.
+		if pendingBalance == 0 {
+			return sp.initiateValidatorExit(st, idx)
+		}
+		return nil
+	}
+
+	balance, err := st.GetBalance(idx)
+	if err != nil {
+		return err
+	}
+	minActivationBalance := math.Gwei(sp.cs.MinActivationBalance())
+	hasSufficientEffectiveBalance := val.GetEffectiveBalance() >= minActivationBalance
+	hasExcessBalance := balance > minActivationBalance+pendingBalance
+	if !hasSufficientEffectiveBalance || !hasExcessBalance {
+		sp.logger.Info("Ignoring partial withdrawal request without excess balance",
+			"validator_index", idx, "balance", balance.Unwrap(),
+		)
+		return nil
+	}
+
+	toWithdraw := min(balance-minActivationBalance-pendingBalance, req.Amount)
+	exitQueueEpoch, err := sp.computeExitEpochAndUpdateChurn(st, currentEpoch, toWithdraw)
+	if err != nil {
+		return err
+	}
+	withdrawableEpoch := exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay())
+	pendingPartialWithdrawals = append(pendingPartialWithdrawals, &ctypes.PendingPartialWithdrawal{
+		ValidatorIndex:    idx,
+		Amount:            toWithdraw,
+		WithdrawableEpoch: withdrawableEpoch,
+	})
+	if err = st.SetPendingPartialWithdrawals(pendingPartialWithdrawals); err != nil {
+		return err
+	}
+
+	sp.logger.Info(
+		"Processed partial withdrawal request",
+		"validator_index", idx,
+		"amount", float64(toWithdraw.Unwrap())/params.GWei,
+		"withdrawable_epoch", withdrawableEpoch,
+	)
+	return nil
+}
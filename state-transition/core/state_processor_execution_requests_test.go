@@ -0,0 +1,448 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionPartialWithdrawalRequest shows that a valid EIP-7002 partial
+// withdrawal request is enqueued into the pending partial withdrawals and paid
+// out once withdrawable, while invalid requests are ignored.
+func TestTransitionPartialWithdrawalRequest(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance   = math.Gwei(cs.MaxEffectiveBalance())
+		increment    = math.Gwei(cs.EffectiveBalanceIncrement())
+		address0     = common.ExecutionAddress{}
+		credentials0 = types.NewCredentialsFromExecutionAddress(address0)
+		address1     = common.ExecutionAddress{0x01}
+		credentials1 = types.NewCredentialsFromExecutionAddress(address1)
+		val1Balance  = 100 * increment
+		toWithdraw   = 10 * increment
+	)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials0,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials1,
+			Amount:      val1Balance,
+			Index:       1,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	// Request a partial withdrawal for validator 1, along with a request
+	// whose source address does not match the withdrawal credentials.
+	requests := &types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{
+				SourceAddress:   address1,
+				ValidatorPubKey: [48]byte{0x01},
+				Amount:          toWithdraw,
+			},
+			{
+				SourceAddress:   address0,
+				ValidatorPubKey: [48]byte{0x01},
+				Amount:          toWithdraw,
+			},
+		},
+	}
+	blk := buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{},
+		requests, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	pending, err := st.GetPendingPartialWithdrawals()
+	require.NoError(t, err)
+	require.Equal(t, []*types.PendingPartialWithdrawal{
+		{
+			ValidatorIndex:    1,
+			Amount:            toWithdraw,
+			WithdrawableEpoch: 2,
+		},
+	}, pending)
+
+	// The pending partial withdrawal is not paid before it is withdrawable.
+	lastSlotEpoch1 := math.Slot(2*cs.SlotsPerEpoch() - 2)
+	progressStateToSlot(t, st, lastSlotEpoch1)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, 11)
+	require.Len(t, withdrawals, 1)
+
+	// Once withdrawable, it is paid out and dequeued.
+	progressStateToSlot(t, st, lastSlotEpoch1+1)
+	timestamp := math.U64(12)
+	withdrawals = expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	require.Len(t, withdrawals, 2)
+	require.Equal(t, math.ValidatorIndex(1), withdrawals[1].GetValidatorIndex())
+	require.Equal(t, toWithdraw, withdrawals[1].GetAmount())
+	require.Equal(t, address1, withdrawals[1].GetAddress())
+
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	val1BalAfter, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, val1Balance-toWithdraw, val1BalAfter)
+
+	pending, err = st.GetPendingPartialWithdrawals()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+// TestTransitionFullExitWithdrawalRequest shows that an EIP-7002 request with
+// a zero amount exits the validator, which is then fully withdrawn.
+func TestTransitionFullExitWithdrawalRequest(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance   = math.Gwei(cs.MaxEffectiveBalance())
+		credentials0 = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		address1     = common.ExecutionAddress{0x01}
+		credentials1 = types.NewCredentialsFromExecutionAddress(address1)
+	)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials0,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: credentials1,
+			Amount:      maxBalance,
+			Index:       1,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	requests := &types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{
+				SourceAddress:   address1,
+				ValidatorPubKey: [48]byte{0x01},
+				Amount:          constants.FullExitRequestAmount,
+			},
+		},
+	}
+	blk := buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{},
+		requests, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	val1, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val1.GetExitEpoch())
+	require.Equal(t, math.Epoch(2), val1.GetWithdrawableEpoch())
+
+	// The validator is removed from the consensus set at the next epoch.
+	progressStateToSlot(t, st, math.Slot(cs.SlotsPerEpoch()-1))
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 11, []*types.Deposit{},
+		st.EVMInflationWithdrawal(11),
+	)
+	vals, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Len(t, vals, 1)
+	require.Equal(t, val1.GetPubkey(), vals[0].Pubkey)
+	require.Equal(t, math.Gwei(0), vals[0].EffectiveBalance)
+
+	// Once withdrawable, the whole balance is withdrawn.
+	progressStateToSlot(t, st, math.Slot(2*cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(12)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	require.Len(t, withdrawals, 2)
+	require.Equal(t, math.ValidatorIndex(1), withdrawals[1].GetValidatorIndex())
+	require.Equal(t, maxBalance, withdrawals[1].GetAmount())
+
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	val1BalAfter, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(0), val1BalAfter)
+}
+
+// TestTransitionDepositRequests shows that legacy deposits are processed up to
+// the first EIP-6110 deposit request, after which deposits are only processed
+// from the execution requests.
+func TestTransitionDepositRequests(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, constants.UnsetDepositRequestsStartIndex, startIndex)
+
+	// The block switching to deposit requests still includes the legacy
+	// deposit preceding the first deposit request.
+	legacyDeposit := &types.Deposit{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      10 * increment,
+		Index:       1,
+	}
+	firstRequest := &types.DepositRequest{
+		Pubkey:      [48]byte{0x02},
+		Credentials: credentials,
+		Amount:      20 * increment,
+		Index:       2,
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), types.Deposits{legacyDeposit}))
+	depRoot := append(genDeposits, legacyDeposit).HashTreeRoot()
+	blk := buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 10, types.Deposits{legacyDeposit},
+		&types.ExecutionRequests{Deposits: []*types.DepositRequest{firstRequest}},
+		st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(blk.GetHeader()))
+
+	startIndex, err = st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, firstRequest.Index, startIndex)
+	depositIndex, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), depositIndex)
+	for _, dep := range []*types.Deposit{legacyDeposit, firstRequest} {
+		idx, errIdx := st.ValidatorIndexByPubkey(dep.Pubkey)
+		require.NoError(t, errIdx)
+		balance, errBal := st.GetBalance(idx)
+		require.NoError(t, errBal)
+		require.Equal(t, dep.Amount, balance)
+	}
+
+	// The deposit store eventually holds the log of the first deposit request
+	// too, but it must not be included as a legacy deposit anymore.
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), types.Deposits{firstRequest}))
+	depRoot = append(genDeposits, legacyDeposit, firstRequest).HashTreeRoot()
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 11, types.Deposits{firstRequest},
+		st.EVMInflationWithdrawal(11),
+	)
+	_, err = sp.Transition(ctx, st.Copy(ctx.ConsensusCtx()), blk)
+	require.ErrorIs(t, err, core.ErrUnexpectedLegacyDeposits)
+
+	// Later deposits, such as top ups, are processed from the requests.
+	topUp := &types.DepositRequest{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      5 * increment,
+		Index:       3,
+	}
+	blk = buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 11, types.Deposits{},
+		&types.ExecutionRequests{Deposits: []*types.DepositRequest{topUp}},
+		st.EVMInflationWithdrawal(11),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, legacyDeposit.Amount+topUp.Amount, balance)
+	depositIndex, err = st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), depositIndex)
+}
+
+// TestTransitionDepositRequestsQueued shows that deposit requests are queued
+// in the pending deposits until the legacy deposits preceding them are
+// processed.
+func TestTransitionDepositRequestsQueued(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       0,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+
+	// The first deposit request comes in before the legacy deposit preceding
+	// it is included in a block, hence it is queued.
+	legacyDeposit := &types.Deposit{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      10 * increment,
+		Index:       1,
+	}
+	firstRequest := &types.DepositRequest{
+		Pubkey:      [48]byte{0x01},
+		Credentials: credentials,
+		Amount:      20 * increment,
+		Index:       2,
+	}
+	depRoot := genDeposits.HashTreeRoot()
+	blk := buildNextBlockWithRequests(
+		t, cs, st, types.NewEth1Data(depRoot), 10, types.Deposits{},
+		&types.ExecutionRequests{Deposits: []*types.DepositRequest{firstRequest}},
+		st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(blk.GetHeader()))
+
+	drained, err := st.LegacyDepositsDrained()
+	require.NoError(t, err)
+	require.False(t, drained)
+	pendingDeposits, err := st.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Deposit{firstRequest}, pendingDeposits)
+	_, err = st.ValidatorIndexByPubkey(firstRequest.Pubkey)
+	require.Error(t, err)
+
+	// Once the legacy deposit is processed, the queued request is applied
+	// right after it.
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), types.Deposits{legacyDeposit}))
+	depRoot = append(genDeposits, legacyDeposit).HashTreeRoot()
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 11, types.Deposits{legacyDeposit},
+		st.EVMInflationWithdrawal(11),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	drained, err = st.LegacyDepositsDrained()
+	require.NoError(t, err)
+	require.True(t, drained)
+	pendingDeposits, err = st.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Empty(t, pendingDeposits)
+	idx, err := st.ValidatorIndexByPubkey(legacyDeposit.Pubkey)
+	require.NoError(t, err)
+	balance, err := st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, legacyDeposit.Amount+firstRequest.Amount, balance)
+}
+
+// expectedWithdrawalsAtNextSlot returns the withdrawals expected in the
+// payload of the next block, computed on a copy of the state advanced to
+// the next slot as the payload builder does.
+func expectedWithdrawalsAtNextSlot(
+	t *testing.T,
+	sp *statetransition.TestStateProcessorT,
+	st *statetransition.TestBeaconStateT,
+	ctx core.ReadOnlyContext,
+	timestamp math.U64,
+) engineprimitives.Withdrawals {
+	t.Helper()
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+
+	stCopy := st.Copy(ctx.ConsensusCtx())
+	_, err = sp.ProcessSlots(stCopy, slot+1)
+	require.NoError(t, err)
+	withdrawals, _, err := stCopy.ExpectedWithdrawals(timestamp)
+	require.NoError(t, err)
+	return withdrawals
+}
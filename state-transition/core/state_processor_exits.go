@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processVoluntaryExits processes the signed voluntary exits included in the
+// block body. Any invalid exit invalidates the block.
+func (sp *StateProcessor) processVoluntaryExits(
+	st *state.StateDB, blk *ctypes.BeaconBlock,
+) error {
+	exits := blk.GetBody().GetVoluntaryExits()
+	if uint64(len(exits)) > constants.MaxVoluntaryExits {
+		return errors.Wrapf(
+			ErrInvalidVoluntaryExit, "expected at most %d exits, got %d",
+			constants.MaxVoluntaryExits, len(exits),
+		)
+	}
+	for _, exit := range exits {
+		if err := sp.VerifyVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+		if err := sp.initiateValidatorExit(st, exit.Message.ValidatorIndex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyVoluntaryExit verifies that the signed voluntary exit can be applied
+// to the given state, as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-process_voluntary_exit
+//
+// NOTE: Modified from the Ethereum 2.0 specification as beacon-kit has no
+// committees, so there is no SHARD_COMMITTEE_PERIOD before an active
+// validator can exit. As in EIP-7044, the signature domain is pinned to the
+// fork voluntary exits are introduced in, so that signed exits remain valid
+// across later forks.
+func (sp *StateProcessor) VerifyVoluntaryExit(
+	st *state.StateDB, signedExit *ctypes.SignedVoluntaryExit,
+) error {
+	if signedExit == nil || signedExit.Message == nil {
+		return errors.Wrap(ErrInvalidVoluntaryExit, "nil voluntary exit")
+	}
+	exit := signedExit.Message
+
+	val, err := st.ValidatorByIndex(exit.ValidatorIndex)
+	if err != nil {
+		return errors.Wrapf(
+			ErrInvalidVoluntaryExit, "unknown validator idx %d: %v", exit.ValidatorIndex, err,
+		)
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+
+	// Verify the validator is active and has not initiated an exit.
+	if !val.IsActive(currentEpoch) {
+		return errors.Wrapf(ErrInvalidVoluntaryExit, "validator idx %d is not active", exit.ValidatorIndex)
+	}
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return errors.Wrapf(ErrInvalidVoluntaryExit, "validator idx %d is already exiting", exit.ValidatorIndex)
+	}
+
+	// Exits must specify an epoch when they become valid; they are not valid before then.
+	if currentEpoch < exit.Epoch {
+		return errors.Wrapf(
+			ErrInvalidVoluntaryExit, "exit epoch %d is after current epoch %d", exit.Epoch, currentEpoch,
+		)
+	}
+
+	// Only exit validator if it has no pending withdrawals in the queue.
+	pendingPartialWithdrawals, err := st.GetPendingPartialWithdrawals()
+	if err != nil {
+		return err
+	}
+	if pendingBalanceToWithdraw(pendingPartialWithdrawals, exit.ValidatorIndex) != 0 {
+		return errors.Wrapf(
+			ErrInvalidVoluntaryExit, "validator idx %d has pending partial withdrawals", exit.ValidatorIndex,
+		)
+	}
+
+	// Verify signature.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	fd := ctypes.NewForkData(version.Electra(), genesisValidatorsRoot)
+	signingRoot := ctypes.ComputeSigningRoot(exit, fd.ComputeDomain(sp.cs.DomainTypeVoluntaryExit()))
+	if err = sp.signer.VerifySignature(
+		val.GetPubkey(), signingRoot[:], signedExit.Signature,
+	); err != nil {
+		return errors.Wrapf(
+			ErrInvalidVoluntaryExit, "validator idx %d: %v", exit.ValidatorIndex, err,
+		)
+	}
+	return nil
+}
+
+// getExitChurnLimit returns the balance that can exit per epoch. Modified
+// from get_activation_exit_churn_limit in the Ethereum 2.0 specification as
+// beacon-kit has no activation churn: the balance churn is driven by
+// dedicated chain spec parameters and only applies to exits.
+func (sp *StateProcessor) getExitChurnLimit(
+	st *state.StateDB, currentEpoch math.Epoch,
+) (math.Gwei, error) {
+	totalActiveBalance, err := sp.getTotalActiveBalance(st, currentEpoch)
+	if err != nil {
+		return 0, err
+	}
+
+	increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	churn := max(
+		math.Gwei(sp.cs.MinPerEpochExitChurnLimit()),
+		totalActiveBalance/math.Gwei(sp.cs.ExitChurnLimitQuotient()),
+	)
+	return churn - churn%increment, nil
+}
+
+// computeExitEpochAndUpdateChurn as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-compute_exit_epoch_and_update_churn
+//
+// NOTE: exits take effect the next epoch in beacon-kit, which is used in place
+// of compute_activation_exit_epoch.
+func (sp *StateProcessor) computeExitEpochAndUpdateChurn(
+	st *state.StateDB, currentEpoch math.Epoch, exitBalance math.Gwei,
+) (math.Epoch, error) {
+	stateEarliestEpoch, err := st.GetEarliestExitEpoch()
+	if err != nil {
+		return 0, err
+	}
+	earliestExitEpoch := max(stateEarliestEpoch, currentEpoch+1)
+	perEpochChurn, err := sp.getExitChurnLimit(st, currentEpoch)
+	if err != nil {
+		return 0, err
+	}
+
+	// New epoch for exits.
+	var exitBalanceToConsume math.Gwei
+	if stateEarliestEpoch < earliestExitEpoch {
+		exitBalanceToConsume = perEpochChurn
+	} else {
+		exitBalanceToConsume, err = st.GetExitBalanceToConsume()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Exit doesn't fit in the current earliest epoch.
+	if exitBalance > exitBalanceToConsume {
+		balanceToProcess := exitBalance - exitBalanceToConsume
+		additionalEpochs := (balanceToProcess-1)/perEpochChurn + 1
+		earliestExitEpoch += math.Epoch(additionalEpochs)
+		exitBalanceToConsume += additionalEpochs * perEpochChurn
+	}
+
+	// Consume the balance and update state variables.
+	if err = st.SetExitBalanceToConsume(exitBalanceToConsume - exitBalance); err != nil {
+		return 0, err
+	}
+	if err = st.SetEarliestExitEpoch(earliestExitEpoch); err != nil {
+		return 0, err
+	}
+	return earliestExitEpoch, nil
+}
+
+// pendingBalanceToWithdraw as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_pending_balance_to_withdraw
+func pendingBalanceToWithdraw(
+	pendingPartialWithdrawals []*ctypes.PendingPartialWithdrawal, idx math.ValidatorIndex,
+) math.Gwei {
+	var total math.Gwei
+	for _, withdrawal := range pendingPartialWithdrawals {
+		if withdrawal.ValidatorIndex == idx {
+			total += withdrawal.Amount
+		}
+	}
+	return total
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionVoluntaryExits shows that signed voluntary exits are queued
+// according to the exit churn, and that the full balance of an exited
+// validator is swept once it becomes withdrawable.
+func TestTransitionVoluntaryExits(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01})
+	)
+
+	genDeposits := make(types.Deposits, 0, 3)
+	for i := range 3 {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		})
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	// Exits that cannot be applied to the state are rejected.
+	err = sp.VerifyVoluntaryExit(st, &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 0},
+	})
+	require.ErrorIs(t, err, core.ErrInvalidVoluntaryExit)
+	err = sp.VerifyVoluntaryExit(st, &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 0, ValidatorIndex: 3},
+	})
+	require.ErrorIs(t, err, core.ErrInvalidVoluntaryExit)
+
+	// The exit churn covers a single fully staked validator per epoch, so the
+	// second exit is queued an epoch later.
+	blk := buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{}, st.EVMInflationWithdrawal(10),
+	)
+	blk.GetBody().SetVoluntaryExits(types.VoluntaryExits{
+		{Message: &types.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+		{Message: &types.VoluntaryExit{Epoch: 0, ValidatorIndex: 1}},
+	})
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	delay := math.Epoch(cs.MinValidatorWithdrawabilityDelay())
+	for idx, exitEpoch := range []math.Epoch{1, 2} {
+		val, valErr := st.ValidatorByIndex(math.ValidatorIndex(idx))
+		require.NoError(t, valErr)
+		require.Equal(t, exitEpoch, val.GetExitEpoch())
+		require.Equal(t, exitEpoch+delay, val.GetWithdrawableEpoch())
+	}
+	earliestExitEpoch, err := st.GetEarliestExitEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), earliestExitEpoch)
+	exitBalanceToConsume, err := st.GetExitBalanceToConsume()
+	require.NoError(t, err)
+	require.Zero(t, exitBalanceToConsume)
+
+	// A validator cannot exit twice.
+	err = sp.VerifyVoluntaryExit(st, &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 0, ValidatorIndex: 0},
+	})
+	require.ErrorIs(t, err, core.ErrInvalidVoluntaryExit)
+
+	// The full balance of the first validator is swept once withdrawable.
+	progressStateToSlot(t, st, math.Slot((1+delay.Unwrap())*cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(11)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	require.Len(t, withdrawals, 2)
+	require.Equal(t, math.ValidatorIndex(0), withdrawals[1].GetValidatorIndex())
+	require.Equal(t, maxBalance, withdrawals[1].GetAmount())
+
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Zero(t, balance)
+}
@@ -22,8 +22,8 @@ package core
 
 import (
 	"fmt"
-	"sync"
 
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
@@ -57,7 +57,8 @@ func (sp *StateProcessor) ProcessFork(
 	}
 
 	// Return early if the given fork version is before or equal to the current state fork version.
-	forkVersion := sp.cs.ActiveForkVersionForTimestamp(timestamp)
+	fork := sp.cs.ActiveFork(timestamp)
+	forkVersion := fork.Version
 	if version.IsBefore(forkVersion, stateFork.CurrentVersion) {
 		return fmt.Errorf(
 			"cannot downgrade state from %s to %s", stateFork.CurrentVersion, forkVersion,
@@ -68,102 +69,99 @@ func (sp *StateProcessor) ProcessFork(
 	}
 
 	// If we are at genesis or moving to a new fork version, upgrade the state.
-	switch forkVersion {
-	case version.Deneb():
-		// Do nothing to the state. NOTE: Deneb is the genesis version of Berachain mainnet and
-		// Bepolia testnet.
-
-		// Log the upgrade to Deneb if requested.
-		if logUpgrade {
-			sp.logDenebFork(timestamp)
-		}
-	case version.Deneb1():
-		// Do nothing to the state. NOTE: Deneb1 is the first hard fork of Berachain mainnet and
-		// Bepolia testnet. In this fork, the Fork struct on BeaconState is NOT updated. In
-		// future hard forks, the Fork struct should be updated.
-
-		// Log the upgrade to Deneb1 if requested.
-		if logUpgrade {
-			sp.logDeneb1Fork(stateFork.PreviousVersion, timestamp, slot)
-		}
-	case version.Electra():
-		if err = sp.upgradeToElectra(st, stateFork, slot); err != nil {
+	upgrade, ok := forkUpgrades[forkVersion]
+	if !ok {
+		return fmt.Errorf("no upgrade registered for fork %s (%s)", fork.Name, forkVersion)
+	}
+	if upgrade != nil {
+		if err = upgrade(sp, st, stateFork, slot); err != nil {
 			return err
 		}
-
-		// Log the upgrade to Electra if requested.
-		if logUpgrade {
-			sp.logElectraFork(stateFork.PreviousVersion, timestamp, slot)
-		}
-	default:
-		panic(fmt.Sprintf("unsupported fork version: %s", forkVersion))
 	}
 
+	// Log the upgrade to the fork if requested.
+	if logUpgrade {
+		sp.logFork(fork, stateFork.PreviousVersion, timestamp, slot)
+	}
 	return nil
 }
 
-// logDenebFork logs information about the Deneb fork.
-func (sp *StateProcessor) logDenebFork(timestamp math.U64) {
-	// Since Deneb is the earliest fork version supported by beacon-kit, if we are
-	// entering Deneb it must be at genesis, which means the fork time of Deneb is
-	// the timestamp of the genesis block itself.
-	denebForkTime := timestamp.Unwrap()
+// ForkUpgradeFn upgrades the state into a fork of the chain spec fork registry.
+type ForkUpgradeFn func(
+	sp *StateProcessor, st *statedb.StateDB, fork *types.Fork, slot math.Slot,
+) error
+
+// forkUpgrades maps every fork version with a registered upgrade to the function
+// upgrading the state into it. The registry of the chain spec decides which of
+// these forks the chain goes through and when each activates. A nil function
+// leaves the state untouched:
+//   - Deneb is the genesis version of Berachain mainnet and Bepolia testnet.
+//   - Deneb1 is the first hard fork of Berachain mainnet and Bepolia testnet. In this fork,
+//     the Fork struct on BeaconState is NOT updated. In future hard forks, the Fork struct
+//     should be updated.
+//
+//nolint:gochecknoglobals // registry of upgrade hooks.
+var forkUpgrades = map[common.Version]ForkUpgradeFn{
+	version.Deneb():   nil,
+	version.Deneb1():  nil,
+	version.Electra(): (*StateProcessor).upgradeToElectra,
+}
+
+// RegisterForkUpgrade registers the function upgrading the state into the given
+// fork version, so that fork registries of chain specs may list it. It must be
+// called before any chain spec listing the fork is built.
+func RegisterForkUpgrade(forkVersion common.Version, upgrade ForkUpgradeFn) {
+	forkUpgrades[forkVersion] = upgrade
+	chain.RegisterForkUpgrade(forkVersion)
+}
 
+// logFork logs information about the fork of the registry the state entered.
+func (sp *StateProcessor) logFork(
+	fork chain.ForkData, previousVersion common.Version, timestamp math.U64, slot math.Slot,
+) {
 	sp.logger.Info(fmt.Sprintf(`
 
 
 	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
 
-	+ ✅  welcome to the deneb (0x04000000) fork! 🎉
-	+ ⏱️   deneb fork time: %d
-	+ 🍴  first slot / timestamp of deneb: %d / %d
+	+ ✅  welcome to the %s (%s) fork! 🎉
+	+ 🚝  previous fork: %s (%s)
+	+ ⏱️   %s fork time: %d
+	+ 🍴  first slot / timestamp of %s: %d / %d
 	+ ⛓️   current beacon epoch: %d
 
 	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
 
 
 `,
-		denebForkTime,
-		constants.GenesisSlot.Unwrap(), denebForkTime,
-		constants.GenesisEpoch.Unwrap(),
+		fork.Name, fork.Version.String(),
+		sp.forkName(previousVersion), previousVersion.String(),
+		fork.Name, fork.Timestamp,
+		fork.Name, slot.Unwrap(), timestamp.Unwrap(),
+		sp.cs.SlotToEpoch(slot).Unwrap(),
 	))
 }
 
-// logDeneb1Fork logs information about the Deneb1 fork.
-func (sp *StateProcessor) logDeneb1Fork(
-	previousVersion common.Version, timestamp math.U64, slot math.Slot,
-) {
-	// Since state fork is not updating to Deneb1, every block observes Deneb1 as "new fork" during
-	// Deneb1. Hence, we must wrap this in a OnceFunc to ensure it is logged only the first time
-	// we process a Deneb1 block.
-	sync.OnceFunc(func() {
-		sp.logger.Info(fmt.Sprintf(`
-
-
-	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
-
-	+ ✅  welcome to the deneb1 (0x04010000) fork! 🎉
-	+ 🚝  previous fork: %s (%s)
-	+ ⏱️   deneb1 fork time: %d
-	+ 🍴  first slot / timestamp of deneb1: %d / %d
-	+ ⛓️   current beacon epoch: %d
-
-	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
-	
-
-`,
-			version.Name(previousVersion), previousVersion.String(),
-			sp.cs.Deneb1ForkTime(),
-			slot.Unwrap(), timestamp.Unwrap(),
-			sp.cs.SlotToEpoch(slot).Unwrap(),
-		))
-	})()
+// forkName returns the name of the fork with the given version in the chain
+// spec fork registry, falling back to the canonical name of the version.
+func (sp *StateProcessor) forkName(forkVersion common.Version) string {
+	if fork, ok := sp.cs.ForkByVersion(forkVersion); ok {
+		return fork.Name
+	}
+	return version.Name(forkVersion)
 }
 
 // upgradeToElectra upgrades the state to the Electra fork version. It is modified from the ETH 2.0
 // spec (https://ethereum.github.io/consensus-specs/specs/electra/fork/#upgrading-the-state) to:
 //   - update the Fork struct in the BeaconState
 //   - initialize the pending partial withdrawals to an empty array
+//   - initialize the pending consolidations to an empty array, with the earliest consolidation
+//     epoch set to the next epoch (the epoch at which exits take effect in beacon-kit) and the
+//     full consolidation churn limit left to consume
+//   - leave the deposit requests start index unset, until the first EIP-6110 deposit request,
+//     and initialize the pending deposits to an empty array
+//   - initialize the exit churn, with the earliest exit epoch set past the exit epoch of any
+//     validator already exiting and the full exit churn limit left to consume
 func (sp *StateProcessor) upgradeToElectra(
 	st *statedb.StateDB, fork *types.Fork, slot math.Slot,
 ) error {
@@ -180,31 +178,46 @@ func (sp *StateProcessor) upgradeToElectra(
 		return err
 	}
 
-	return nil
-}
-
-// logElectraFork logs information about the Electra fork.
-func (sp *StateProcessor) logElectraFork(
-	previousVersion common.Version, timestamp math.U64, slot math.Slot,
-) {
-	sp.logger.Info(fmt.Sprintf(`
-
-
-	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
-
-	+ ✅  welcome to the electra (0x05000000) fork! 🎉
-	+ 🚝  previous fork: %s (%s)
-	+ ⏱️   electra fork time: %d
-	+ 🍴  first slot / timestamp of electra: %d / %d
-	+ ⛓️   current beacon epoch: %d
-
-	⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️⏭️
+	// Initialize the consolidation churn and the pending consolidations.
+	if err := st.SetEarliestConsolidationEpoch(fork.Epoch + 1); err != nil {
+		return err
+	}
+	churnLimit, err := sp.getConsolidationChurnLimit(st, fork.Epoch)
+	if err != nil {
+		return err
+	}
+	if err = st.SetConsolidationBalanceToConsume(churnLimit); err != nil {
+		return err
+	}
+	if err = st.SetPendingConsolidations([]*types.PendingConsolidation{}); err != nil {
+		return err
+	}
 
+	// Legacy deposits are processed until the first deposit request is seen.
+	if err = st.SetDepositRequestsStartIndex(constants.UnsetDepositRequestsStartIndex); err != nil {
+		return err
+	}
+	if err = st.SetPendingDeposits([]*types.Deposit{}); err != nil {
+		return err
+	}
 
-`,
-		version.Name(previousVersion), previousVersion.String(),
-		sp.cs.ElectraForkTime(),
-		slot.Unwrap(), timestamp.Unwrap(),
-		sp.cs.SlotToEpoch(slot).Unwrap(),
-	))
+	// Initialize the exit churn, queueing new exits after the ones already initiated.
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	earliestExitEpoch := fork.Epoch + 1
+	for _, val := range vals {
+		if exitEpoch := val.GetExitEpoch(); exitEpoch != math.Epoch(constants.FarFutureEpoch) {
+			earliestExitEpoch = max(earliestExitEpoch, exitEpoch+1)
+		}
+	}
+	if err = st.SetEarliestExitEpoch(earliestExitEpoch); err != nil {
+		return err
+	}
+	exitChurnLimit, err := sp.getExitChurnLimit(st, fork.Epoch)
+	if err != nil {
+		return err
+	}
+	return st.SetExitBalanceToConsume(exitChurnLimit)
 }
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProcessForkFollowsRegistry shows that the state goes through the forks
+// listed by the fork registry of the chain spec, skipping supported forks the
+// registry omits and running the upgrade registered for a chain-specific fork.
+//
+// The test is not parallel, as it registers an upgrade hook.
+//
+//nolint:paralleltest // registers an upgrade hook.
+func TestProcessForkFollowsRegistry(t *testing.T) {
+	customVersion := common.Version{0x06, 0x00, 0x00, 0x00}
+	var customUpgrades int
+	core.RegisterForkUpgrade(customVersion, func(
+		_ *core.StateProcessor, st *statedb.StateDB, fork *types.Fork, _ math.Slot,
+	) error {
+		customUpgrades++
+		fork.PreviousVersion = fork.CurrentVersion
+		fork.CurrentVersion = customVersion
+		return st.SetFork(fork)
+	})
+
+	specData := spec.DevnetChainSpecData()
+	specData.Forks = []chain.ForkData{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+		{Name: "electra", Version: version.Electra(), Timestamp: 10},
+		{Name: "custom", Version: customVersion, Timestamp: 20},
+	}
+	cs, err := chain.NewSpec(specData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	genDeposits := types.Deposits{{
+		Pubkey:      [48]byte{0x01},
+		Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+		Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+	}}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	progressStateToSlot(t, st, math.U64(1))
+
+	// Deneb1 is not in the registry, so the state upgrades from Deneb
+	// straight to Electra.
+	require.NoError(t, sp.ProcessFork(st, 10, false))
+	fork, err := st.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, version.Deneb(), fork.PreviousVersion)
+	require.Equal(t, version.Electra(), fork.CurrentVersion)
+	require.Zero(t, customUpgrades)
+
+	// The chain-specific fork runs its registered upgrade, once.
+	require.NoError(t, sp.ProcessFork(st, 20, false))
+	require.NoError(t, sp.ProcessFork(st, 25, false))
+	fork, err = st.GetFork()
+	require.NoError(t, err)
+	require.Equal(t, version.Electra(), fork.PreviousVersion)
+	require.Equal(t, customVersion, fork.CurrentVersion)
+	require.Equal(t, 1, customUpgrades)
+}
@@ -28,16 +28,22 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
 
 // InitializeBeaconStateFromEth1 initializes the beacon state. Modified from the ETH 2.0 spec:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#genesis
+//
+// If restart is set, the genesis was exported from the state of an existing chain: the
+// deposits are all the deposits included in that chain, and the validators and the rest
+// of the exported state are restored from restart instead of being created from them.
 func (sp *StateProcessor) InitializeBeaconStateFromEth1(
 	st *statedb.StateDB,
 	deposits ctypes.Deposits,
 	execPayloadHeader *ctypes.ExecutionPayloadHeader,
 	genesisVersion common.Version,
+	restart *ctypes.GenesisRestart,
 ) (transition.ValidatorUpdates, error) {
 	if err := st.SetSlot(constants.GenesisSlot); err != nil {
 		return nil, err
@@ -88,8 +94,13 @@ func (sp *StateProcessor) InitializeBeaconStateFromEth1(
 		return nil, err
 	}
 
-	// ingest deposits & do genesis‐activation
-	if err := sp.processGenesisDepositsAndActivations(st, deposits); err != nil {
+	// ingest deposits & do genesis‐activation, unless the validators are
+	// restored from an exported state.
+	if restart == nil {
+		if err := sp.processGenesisDepositsAndActivations(st, deposits); err != nil {
+			return nil, err
+		}
+	} else if err := sp.restoreGenesisValidators(st, deposits, restart); err != nil {
 		return nil, err
 	}
 
@@ -122,6 +133,12 @@ func (sp *StateProcessor) InitializeBeaconStateFromEth1(
 		return nil, err
 	}
 
+	if restart != nil {
+		if err = sp.restoreGenesisState(st, restart); err != nil {
+			return nil, err
+		}
+	}
+
 	activeVals, err := getActiveVals(st, constants.GenesisEpoch)
 	if err != nil {
 		return nil, err
@@ -180,6 +197,78 @@ func (sp *StateProcessor) processGenesisDepositsAndActivations(
 	return sp.processGenesisActivation(st)
 }
 
+// restoreGenesisValidators restores the validator registry of an exported
+// genesis. The validators already proved possession of their keys on the
+// exported chain, and its deposits carry no signature the restarted chain
+// could verify, so the deposits are only checked to be the ones the exported
+// state included.
+func (sp *StateProcessor) restoreGenesisValidators(
+	st *statedb.StateDB,
+	deposits ctypes.Deposits,
+	restart *ctypes.GenesisRestart,
+) error {
+	if err := validateGenesisRestart(deposits, restart); err != nil {
+		return err
+	}
+	if err := st.SetEth1DepositIndex(restart.Eth1DepositIndex); err != nil {
+		return err
+	}
+
+	for i, val := range restart.Validators {
+		if _, err := st.ValidatorIndexByPubkey(val.GetPubkey()); err == nil {
+			return fmt.Errorf("exported validator %d duplicates validator %s", i, val.GetPubkey())
+		}
+		if err := st.AddValidator(val); err != nil {
+			return err
+		}
+		if err := st.SetBalance(math.ValidatorIndex(i), restart.Balances[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreGenesisState restores the withdrawals, the pending partial
+// withdrawals and the slashings of an exported genesis.
+func (sp *StateProcessor) restoreGenesisState(
+	st *statedb.StateDB,
+	restart *ctypes.GenesisRestart,
+) error {
+	if err := st.SetNextWithdrawalIndex(restart.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(restart.NextWithdrawalValidatorIndex); err != nil {
+		return err
+	}
+
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if version.IsBefore(fork.CurrentVersion, version.Electra()) {
+		if len(restart.PendingPartialWithdrawals) > 0 {
+			return fmt.Errorf(
+				"pending partial withdrawals cannot be restored before %s", version.Name(version.Electra()),
+			)
+		}
+	} else if err = st.SetPendingPartialWithdrawals(restart.PendingPartialWithdrawals); err != nil {
+		return err
+	}
+
+	if uint64(len(restart.Slashings)) > sp.cs.EpochsPerSlashingsVector() {
+		return fmt.Errorf(
+			"exported slashings vector has %d entries, more than %d",
+			len(restart.Slashings), sp.cs.EpochsPerSlashingsVector(),
+		)
+	}
+	for i, slashing := range restart.Slashings {
+		if err = st.SetSlashingAtIndex(uint64(i), slashing); err != nil {
+			return err
+		}
+	}
+	return st.SetTotalSlashing(restart.TotalSlashing)
+}
+
 func (sp *StateProcessor) processGenesisActivation(st *statedb.StateDB) error {
 	vals, err := st.GetValidators()
 	if err != nil {
@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
 	"github.com/stretchr/testify/require"
 )
@@ -140,7 +141,7 @@ func TestInitialize(t *testing.T) {
 
 			// run test
 			genVals, initErr := sp.InitializeBeaconStateFromEth1(
-				st, genDeposits, executionPayloadHeader, fork.CurrentVersion,
+				st, genDeposits, executionPayloadHeader, fork.CurrentVersion, nil,
 			)
 
 			// check outputs
@@ -169,6 +170,123 @@ func TestInitialize(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // uses envars
+func TestInitializeRejectsInconsistentRestart(t *testing.T) {
+	cs := setupChain(t)
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	increment := math.Gwei(cs.EffectiveBalanceIncrement())
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey: [48]byte{0x01},
+			Amount: maxBalance,
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x01},
+			),
+			Index: uint64(0),
+		},
+		{
+			Pubkey: [48]byte{0x02},
+			Amount: maxBalance,
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x02},
+			),
+			Index: uint64(1),
+		},
+	}
+	validRestart := func() *types.GenesisRestart {
+		restart := &types.GenesisRestart{
+			Eth1DepositIndex: uint64(len(genDeposits)),
+			DepositRoot:      genDeposits.HashTreeRoot(),
+		}
+		for _, dep := range genDeposits {
+			val := types.NewValidatorFromDeposit(
+				dep.Pubkey, dep.Credentials, dep.Amount, increment, maxBalance,
+			)
+			val.ActivationEligibilityEpoch = constants.GenesisEpoch
+			val.ActivationEpoch = constants.GenesisEpoch
+			restart.Validators = append(restart.Validators, val)
+			restart.Balances = append(restart.Balances, dep.Amount)
+		}
+		return restart
+	}
+
+	tests := []struct {
+		name        string
+		deposits    types.Deposits
+		mutate      func(*types.GenesisRestart)
+		expectedErr error
+	}{
+		{
+			name:     "consistent restart",
+			deposits: genDeposits,
+			mutate:   func(*types.GenesisRestart) {},
+		},
+		{
+			name:        "deposits count differs from eth1 deposit index",
+			deposits:    genDeposits[:1],
+			mutate:      func(*types.GenesisRestart) {},
+			expectedErr: core.ErrDepositsLengthMismatch,
+		},
+		{
+			name:     "deposits differ from the exported deposit root",
+			deposits: genDeposits,
+			mutate: func(r *types.GenesisRestart) {
+				r.DepositRoot = common.Root{0x01}
+			},
+			expectedErr: core.ErrDepositsRootMismatch,
+		},
+		{
+			name:     "balances do not match validators",
+			deposits: genDeposits,
+			mutate: func(r *types.GenesisRestart) {
+				r.Balances = r.Balances[:1]
+			},
+			expectedErr: core.ErrInvalidGenesisRestart,
+		},
+		{
+			name:     "next withdrawal validator out of the registry",
+			deposits: genDeposits,
+			mutate: func(r *types.GenesisRestart) {
+				r.NextWithdrawalValidatorIndex = math.ValidatorIndex(len(r.Validators))
+			},
+			expectedErr: core.ErrInvalidGenesisRestart,
+		},
+		{
+			name:     "pending partial withdrawal of unknown validator",
+			deposits: genDeposits,
+			mutate: func(r *types.GenesisRestart) {
+				r.PendingPartialWithdrawals = []*types.PendingPartialWithdrawal{
+					{ValidatorIndex: math.ValidatorIndex(len(r.Validators))},
+				}
+			},
+			expectedErr: core.ErrInvalidGenesisRestart,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+			restart := validRestart()
+			tt.mutate(restart)
+
+			genVals, err := sp.InitializeBeaconStateFromEth1(
+				st, tt.deposits, types.NewEmptyExecutionPayloadHeaderWithVersion(cs.GenesisForkVersion()),
+				cs.GenesisForkVersion(), restart,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, genVals, len(genDeposits))
+
+			depositIndex, err := st.GetEth1DepositIndex()
+			require.NoError(t, err)
+			require.Equal(t, restart.Eth1DepositIndex, depositIndex)
+		})
+	}
+}
+
 func checkValidator(
 	t *testing.T,
 	cs chain.Spec,
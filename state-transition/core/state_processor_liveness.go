@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processLiveness records the validators that did not sign the previous
+// block, as reported by consensus in the commit info of the block. Missed
+// signatures are counted per epoch within the liveness window.
+func (sp *StateProcessor) processLiveness(ctx ReadOnlyContext, st *state.StateDB) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	windowIdx := sp.cs.SlotToEpoch(slot).Unwrap() % sp.cs.LivenessWindowEpochs()
+
+	for _, vote := range ctx.LastCommitVotes() {
+		if vote.Signed {
+			continue
+		}
+		idx, errInLoop := st.ValidatorIndexByCometBFTAddress(vote.ValidatorAddress)
+		if errInLoop != nil {
+			if errors.Is(errInLoop, collections.ErrNotFound) {
+				continue
+			}
+			return errInLoop
+		}
+		if errInLoop = st.IncrementMissedSignatures(windowIdx, idx); errInLoop != nil {
+			return errInLoop
+		}
+	}
+	return nil
+}
+
+// processLivenessUpdates ejects the active validators that missed signing
+// more than MaxMissedSignaturesPerWindow blocks within the liveness window,
+// then slides the window forward by resetting the counters of the oldest
+// epoch. At most MaxLivenessEjectionsPerEpoch validators are ejected per
+// epoch, by increasing validator index; the others are ejected in the
+// following epochs if they stay offline.
+func (sp *StateProcessor) processLivenessUpdates(st *state.StateDB) error {
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if !version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+	windowEpochs := sp.cs.LivenessWindowEpochs()
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	var ejections uint64
+	for i, val := range vals {
+		if ejections >= sp.cs.MaxLivenessEjectionsPerEpoch() {
+			break
+		}
+		if !val.IsActive(currentEpoch) ||
+			val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		missed, errInLoop := st.GetMissedSignaturesInWindow(idx)
+		if errInLoop != nil {
+			return errInLoop
+		}
+		if missed <= sp.cs.MaxMissedSignaturesPerWindow() {
+			continue
+		}
+
+		sp.logger.Info("Ejecting offline validator",
+			"validator_index", idx, "missed_signatures", missed,
+		)
+		if err = sp.initiateValidatorExit(st, idx); err != nil {
+			return fmt.Errorf("failed ejecting offline validator idx %d: %w", idx, err)
+		}
+		ejections++
+	}
+
+	return st.ResetMissedSignatures((currentEpoch.Unwrap() + 1) % windowEpochs)
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionEjectOfflineValidator shows that missed block signatures
+// reported by consensus are tracked per epoch, that a validator missing more
+// than the allowed signatures within the liveness window is ejected at the
+// epoch boundary and that the window slides forward.
+func TestTransitionEjectOfflineValidator(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	)
+
+	genDeposits := make(types.Deposits, 0, 3)
+	for i := range 3 {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		})
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	// Validator 1 has already missed all the allowed signatures in a previous
+	// epoch of the window, while validator 2 missed a few in the epoch that
+	// the window slides over next.
+	maxMissed := cs.MaxMissedSignaturesPerWindow()
+	seedMissedSignatures(t, st, cs.LivenessWindowEpochs()-1, 1, maxMissed)
+	seedMissedSignatures(t, st, 1, 2, 5)
+
+	pk0, pk1 := genDeposits[0].Pubkey, genDeposits[1].Pubkey
+	votesCtx := transition.NewTransitionCtx(
+		ctx.ConsensusCtx(),
+		0, // time
+		statetransition.DummyProposerAddr,
+	).
+		WithLastCommitVotes([]transition.Vote{
+			{ValidatorAddress: cmtcrypto.AddressHash(pk0[:]), Signed: true},
+			{ValidatorAddress: cmtcrypto.AddressHash(pk1[:]), Signed: false},
+			// Ignored, as the validator is unknown.
+			{ValidatorAddress: []byte{0xff}, Signed: false},
+		}).
+		WithVerifyPayload(false).
+		WithVerifyRandao(false).
+		WithVerifyResult(false).
+		WithMeterGas(false)
+
+	blk := buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{}, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(votesCtx, st, blk)
+	require.NoError(t, err)
+
+	missed, err := st.GetMissedSignatures(0, 0)
+	require.NoError(t, err)
+	require.Zero(t, missed)
+	missed, err = st.GetMissedSignatures(0, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), missed)
+	missed, err = st.GetMissedSignaturesInWindow(1)
+	require.NoError(t, err)
+	require.Equal(t, maxMissed+1, missed)
+
+	// The validator is only ejected at the epoch boundary.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+
+	progressStateToSlot(t, st, math.Slot(cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(11)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	valUpdates, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		{Pubkey: pk1, EffectiveBalance: 0},
+	}, valUpdates)
+
+	val, err = st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	val, err = st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+
+	// The counters of the epoch entering the window are reset and removed
+	// from the window totals.
+	missed, err = st.GetMissedSignatures(1, 2)
+	require.NoError(t, err)
+	require.Zero(t, missed)
+	missed, err = st.GetMissedSignaturesInWindow(2)
+	require.NoError(t, err)
+	require.Zero(t, missed)
+}
+
+// TestTransitionEjectOfflineValidatorsCapped shows that at most
+// MaxLivenessEjectionsPerEpoch offline validators are ejected per epoch, by
+// increasing validator index.
+func TestTransitionEjectOfflineValidatorsCapped(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+		maxEjected  = cs.MaxLivenessEjectionsPerEpoch()
+		numOffline  = maxEjected + 1
+	)
+
+	// Validator 0 stays online while all the others are offline.
+	genDeposits := make(types.Deposits, 0, numOffline+1)
+	for i := range numOffline + 1 {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       i,
+		})
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	for i := range numOffline {
+		seedMissedSignatures(
+			t, st, cs.LivenessWindowEpochs()-1, math.ValidatorIndex(i+1),
+			cs.MaxMissedSignaturesPerWindow()+1,
+		)
+	}
+
+	progressStateToSlot(t, st, math.Slot(cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(10)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk := buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	// The exits of the ejected validators are spread over the following
+	// epochs by the exit churn.
+	for i := range numOffline {
+		val, errVal := st.ValidatorByIndex(math.ValidatorIndex(i + 1))
+		require.NoError(t, errVal)
+		if i < maxEjected {
+			require.NotEqual(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+		} else {
+			require.Equal(t, math.Epoch(constants.FarFutureEpoch), val.GetExitEpoch())
+		}
+	}
+}
+
+// seedMissedSignatures records missed block signatures of the validator in
+// the given liveness window index.
+func seedMissedSignatures(
+	t *testing.T,
+	st *statetransition.TestBeaconStateT,
+	windowIdx uint64,
+	idx math.ValidatorIndex,
+	missed uint64,
+) {
+	t.Helper()
+	for range missed {
+		require.NoError(t, st.IncrementMissedSignatures(windowIdx, idx))
+	}
+}
@@ -26,8 +26,8 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"golang.org/x/sync/errgroup"
 )
@@ -59,19 +59,6 @@ func (sp *StateProcessor) processExecutionPayload(
 		"verify payload", txCtx.VerifyPayload(),
 	)
 
-	if version.EqualsOrIsAfter(blk.GetForkVersion(), version.Electra()) {
-		requests, getErr := blk.GetBody().GetExecutionRequests()
-		if getErr != nil {
-			return getErr
-		}
-		sp.logger.Info(
-			"Processing execution requests",
-			"deposits", len(requests.Deposits),
-			"withdrawals", len(requests.Withdrawals),
-			"consolidations", len(requests.Consolidations),
-		)
-	}
-
 	// Perform payload verification only if the context is configured as such.
 	if txCtx.VerifyPayload() {
 		g.Go(func() error {
@@ -91,49 +78,102 @@ func (sp *StateProcessor) processExecutionPayload(
 	}
 
 	if txCtx.MeterGas() {
-		sp.metrics.gaugeBlockGasUsed(
-			payload.GetNumber(), payload.GetGasUsed(), payload.GetBlobGasUsed(),
-		)
+		sp.MeterGas(blk)
 	}
 
 	// Set the latest execution payload header.
 	return st.SetLatestExecutionPayloadHeader(header)
 }
 
+// MeterGas records the gas used by the execution payload of the block.
+func (sp *StateProcessor) MeterGas(blk *ctypes.BeaconBlock) {
+	payload := blk.GetBody().GetExecutionPayload()
+	sp.metrics.gaugeBlockGasUsed(
+		payload.GetNumber(), payload.GetGasUsed(), payload.GetBlobGasUsed(),
+	)
+}
+
 // validateExecutionPayload validates the execution payload against both local
 // state and the execution engine.
+//
+// NOTE: stateless checks are run early on in ProcessProposal, through
+// ValidateStatelessPayload, before the state is loaded.
 func (sp *StateProcessor) validateExecutionPayload(
 	ctx context.Context,
 	consensusTime math.U64,
 	st ReadOnlyBeaconState,
 	blk *ctypes.BeaconBlock,
 ) error {
-	// NOTE: skip consensus block
-	// if err := sp.validateStatelessPayload(blk); err != nil {
-	// 	return err
-	// }
 	return sp.validateStatefulPayload(ctx, consensusTime, st, blk)
 }
 
-// validateStatelessPayload performs stateless checks on the execution payload.
-// func (sp *StateProcessor) validateStatelessPayload(blk *ctypes.BeaconBlock) error {
-// 	body := blk.GetBody()
-// 	payload := body.GetExecutionPayload()
-
-// 	// Verify the number of withdrawals.
-// 	withdrawals := payload.GetWithdrawals()
-// 	if uint64(len(withdrawals)) > sp.cs.MaxWithdrawalsPerPayload() {
-// 		return errors.Wrapf(
-// 			ErrExceedMaximumWithdrawals,
-// 			"too many withdrawals, expected: %d, got: %d",
-// 			sp.cs.MaxWithdrawalsPerPayload(), len(withdrawals),
-// 		)
-// 	}
-
-// 	// No need to verify bounded number of commitments here, since it is
-// 	// verified early on in ProcessProposal.
-// 	return nil
-// }
+// ValidateStatelessPayload performs cheap checks on the execution payload of
+// the block which require neither the state nor the execution engine, so that
+// malformed proposals can be rejected before any state access.
+//
+// NOTE: the upper bound of the payload timestamp depends on the timestamp of
+// the parent payload, so it is only verified against the state.
+func (sp *StateProcessor) ValidateStatelessPayload(blk *ctypes.BeaconBlock) error {
+	body := blk.GetBody()
+	payload := body.GetExecutionPayload()
+
+	// Verify the number of withdrawals. The EVM inflation withdrawal is always
+	// expected.
+	withdrawals := payload.GetWithdrawals()
+	if len(withdrawals) == 0 {
+		return ErrZeroWithdrawals
+	}
+	if uint64(len(withdrawals)) > sp.cs.MaxWithdrawalsPerPayload() {
+		return errors.Wrapf(
+			ErrExceedMaximumWithdrawals,
+			"too many withdrawals, expected: %d, got: %d",
+			sp.cs.MaxWithdrawalsPerPayload(), len(withdrawals),
+		)
+	}
+
+	// Verify the number of blob commitments.
+	numCommitments := uint64(len(body.GetBlobKzgCommitments()))
+	if numCommitments > sp.cs.MaxBlobCommitmentsPerBlock() {
+		return errors.Wrapf(
+			ErrExceedsBlockBlobLimit,
+			"too many blob commitments, expected: %d, got: %d",
+			sp.cs.MaxBlobCommitmentsPerBlock(), numCommitments,
+		)
+	}
+
+	// Verify the size of the extra data.
+	if extraData := payload.GetExtraData(); len(extraData) > ctypes.ExtraDataSize {
+		return errors.Wrapf(
+			ErrExtraDataTooLong, "expected at most %d bytes, got: %d",
+			ctypes.ExtraDataSize, len(extraData),
+		)
+	}
+
+	// Verify the payload is not timestamped before genesis.
+	if timestamp := payload.GetTimestamp(); timestamp.Unwrap() < sp.cs.GenesisTime() {
+		return errors.Wrapf(
+			ErrPayloadTimestampBeforeGenesis, "genesis time: %d, got: %d",
+			sp.cs.GenesisTime(), timestamp,
+		)
+	}
+
+	// Verify the transactions list, transactions can't be empty.
+	txs := payload.GetTransactions()
+	if uint64(len(txs)) > constants.MaxTxsPerPayload {
+		return errors.Wrapf(
+			ErrInvalidTransactions, "too many transactions, expected: %d, got: %d",
+			constants.MaxTxsPerPayload, len(txs),
+		)
+	}
+	for i, tx := range txs {
+		if len(tx) == 0 || uint64(len(tx)) > constants.MaxBytesPerTx {
+			return errors.Wrapf(
+				ErrInvalidTransactions, "transaction %d has invalid size %d", i, len(tx),
+			)
+		}
+	}
+	return nil
+}
 
 // validateStatefulPayload performs stateful checks on the execution payload.
 func (sp *StateProcessor) validateStatefulPayload(
@@ -30,10 +30,14 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -69,7 +73,7 @@ func TestPayloadTimestampVerification(t *testing.T) {
 	)
 	genPayloadHeader.Timestamp = math.U64(genesisTime.Unix())
 
-	_, err := sp.InitializeBeaconStateFromEth1(st, genDeposits, genPayloadHeader, genesisFork)
+	_, err := sp.InitializeBeaconStateFromEth1(st, genDeposits, genPayloadHeader, genesisFork, nil)
 	require.NoError(t, err)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 
@@ -158,3 +162,100 @@ func TestPayloadTimestampVerification(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateStatelessPayload ensures that malformed payloads are rejected
+// without accessing the state.
+func TestValidateStatelessPayload(t *testing.T) {
+	t.Parallel()
+	csData := spec.DevnetChainSpecData()
+	csData.GenesisTime = 5
+	cs, err := chain.NewSpec(csData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x00},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+			Amount:      math.Gwei(cs.MaxEffectiveBalance()),
+			Index:       0,
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		malleate    func(body *types.BeaconBlockBody)
+		expectedErr error
+	}{
+		{
+			name:     "valid payload",
+			malleate: func(*types.BeaconBlockBody) {},
+		},
+		{
+			name: "no withdrawals",
+			malleate: func(body *types.BeaconBlockBody) {
+				body.ExecutionPayload.Withdrawals = nil
+			},
+			expectedErr: core.ErrZeroWithdrawals,
+		},
+		{
+			name: "too many withdrawals",
+			malleate: func(body *types.BeaconBlockBody) {
+				withdrawal := body.ExecutionPayload.Withdrawals[0]
+				for range cs.MaxWithdrawalsPerPayload() {
+					body.ExecutionPayload.Withdrawals = append(body.ExecutionPayload.Withdrawals, withdrawal)
+				}
+			},
+			expectedErr: core.ErrExceedMaximumWithdrawals,
+		},
+		{
+			name: "too many blob commitments",
+			malleate: func(body *types.BeaconBlockBody) {
+				body.BlobKzgCommitments = make([]eip4844.KZGCommitment, cs.MaxBlobCommitmentsPerBlock()+1)
+			},
+			expectedErr: core.ErrExceedsBlockBlobLimit,
+		},
+		{
+			name: "extra data too long",
+			malleate: func(body *types.BeaconBlockBody) {
+				body.ExecutionPayload.ExtraData = make([]byte, types.ExtraDataSize+1)
+			},
+			expectedErr: core.ErrExtraDataTooLong,
+		},
+		{
+			name: "timestamp before genesis",
+			malleate: func(body *types.BeaconBlockBody) {
+				body.ExecutionPayload.Timestamp = math.U64(cs.GenesisTime() - 1)
+			},
+			expectedErr: core.ErrPayloadTimestampBeforeGenesis,
+		},
+		{
+			name: "empty transaction",
+			malleate: func(body *types.BeaconBlockBody) {
+				body.ExecutionPayload.Transactions = [][]byte{{0x01}, {}}
+			},
+			expectedErr: core.ErrInvalidTransactions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blk := buildNextBlock(
+				t, cs, st, types.NewEth1Data(genDeposits.HashTreeRoot()), 10, nil, st.EVMInflationWithdrawal(10),
+			)
+			tt.malleate(blk.GetBody())
+
+			err = sp.ValidateStatelessPayload(blk)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
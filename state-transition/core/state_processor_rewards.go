@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processProposerReward credits the consensus layer reward of the block to the
+// beacon balance of its proposer. Rewards accrue to the balance like deposits
+// do, so the part of the balance above the max effective balance is paid out
+// by the withdrawal sweep.
+//
+// NOTE: beacon-kit has no attestations nor sync committees, so the fixed per
+// block reward of the proposer is the only consensus layer reward. It is zero,
+// and nothing is credited, before the proposer reward fork.
+func (sp *StateProcessor) processProposerReward(
+	ctx ReadOnlyContext, st *state.StateDB, blk *ctypes.BeaconBlock,
+) error {
+	reward := sp.cs.ProposerRewardPerBlock(blk.GetTimestamp())
+	if reward == 0 {
+		return nil
+	}
+
+	// The block header is not verified against consensus, so make sure the
+	// reward goes to the proposer declared by consensus.
+	proposerIndex := blk.GetProposerIndex()
+	proposer, err := st.ValidatorByIndex(proposerIndex)
+	if err != nil {
+		return err
+	}
+	proposerAddress, err := sp.fGetAddressFromPubKey(proposer.GetPubkey())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(proposerAddress, ctx.ProposerAddress()) {
+		return errors.Wrapf(
+			ErrProposerMismatch, "store key: %s, consensus key: %s",
+			proposerAddress, ctx.ProposerAddress(),
+		)
+	}
+	return st.IncreaseBalance(proposerIndex, math.Gwei(reward))
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionProposerReward shows that proposers are only rewarded from the
+// proposer reward fork, and that the reward is paid out by the withdrawal
+// sweep once it lifts the balance above the max effective balance.
+func TestTransitionProposerReward(t *testing.T) {
+	t.Parallel()
+	csData := spec.DevnetChainSpecData()
+	csData.ProposerRewardForkTime = 20
+	csData.ProposerRewardPerBlock = 1e9
+	cs, err := chain.NewSpec(csData)
+	require.NoError(t, err)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		reward      = math.Gwei(csData.ProposerRewardPerBlock)
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	)
+
+	genDeposits := make(types.Deposits, 0, 2)
+	for i := range 2 {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		})
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err = sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	// No reward before the proposer reward fork.
+	blk := buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{}, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	balance, err := st.GetBalance(blk.GetProposerIndex())
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+
+	// From the fork the proposer is credited the reward.
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 20, []*types.Deposit{}, st.EVMInflationWithdrawal(20),
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	balance, err = st.GetBalance(blk.GetProposerIndex())
+	require.NoError(t, err)
+	require.Equal(t, maxBalance+reward, balance)
+
+	// The reward is swept in the following block, which rewards the proposer
+	// once more.
+	timestamp := math.U64(30)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	require.Len(t, withdrawals, 2)
+	require.Equal(t, blk.GetProposerIndex(), withdrawals[1].GetValidatorIndex())
+	require.Equal(t, reward, withdrawals[1].GetAmount())
+
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	balance, err = st.GetBalance(blk.GetProposerIndex())
+	require.NoError(t, err)
+	require.Equal(t, maxBalance+reward, balance)
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// processMisbehaviors slashes the validators whose misbehaviors, i.e. CometBFT
+// duplicate votes and light client attacks, have been committed to by
+// consensus for the block. It takes the place of the proposer and attester
+// slashings operations of the Ethereum 2.0 specification.
+//
+// Misbehaviors of validators which are unknown or no longer slashable are
+// ignored, as consensus may report evidence long after it was committed.
+func (sp *StateProcessor) processMisbehaviors(ctx ReadOnlyContext, st *state.StateDB) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	currentEpoch := sp.cs.SlotToEpoch(slot)
+
+	for _, misbehavior := range ctx.Misbehaviors() {
+		idx, errInLoop := st.ValidatorIndexByCometBFTAddress(misbehavior.ValidatorAddress)
+		if errInLoop != nil {
+			if errors.Is(errInLoop, collections.ErrNotFound) {
+				sp.logger.Warn("Ignoring misbehavior of unknown validator",
+					"address", hex.EncodeToString(misbehavior.ValidatorAddress),
+					"height", misbehavior.Height,
+				)
+				continue
+			}
+			return errInLoop
+		}
+		val, errInLoop := st.ValidatorByIndex(idx)
+		if errInLoop != nil {
+			return errInLoop
+		}
+		if !val.IsSlashable(currentEpoch) {
+			sp.logger.Info("Ignoring misbehavior of non slashable validator",
+				"validator_index", idx, "height", misbehavior.Height,
+			)
+			continue
+		}
+		if errInLoop = sp.slashValidator(st, idx); errInLoop != nil {
+			return errInLoop
+		}
+	}
+	return nil
+}
+
+// slashValidator as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-slash_validator
+//
+// NOTE: Modified from the Ethereum 2.0 specification as misbehaviors are
+// reported by consensus rather than by a whistleblower: no whistleblower nor
+// proposer reward is paid out of the slashed balance.
+func (sp *StateProcessor) slashValidator(st *state.StateDB, idx math.ValidatorIndex) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	if err = sp.initiateValidatorExit(st, idx); err != nil {
+		return err
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return fmt.Errorf("slash, failed updating validator idx %d: %w", idx, err)
+	}
+
+	// Record the slashed effective balance for the proportional slashing.
+	effectiveBalance := val.GetEffectiveBalance()
+	slashingIdx := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(slashingIdx)
+	if err != nil {
+		return err
+	}
+	if err = st.SetSlashingAtIndex(slashingIdx, slashing+effectiveBalance); err != nil {
+		return err
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+	if err = st.SetTotalSlashing(totalSlashing + effectiveBalance); err != nil {
+		return err
+	}
+
+	// Apply the initial penalty.
+	penalty := effectiveBalance / math.Gwei(sp.cs.MinSlashingPenaltyQuotient())
+	if err = st.DecreaseBalance(idx, penalty); err != nil {
+		return err
+	}
+
+	sp.logger.Info("Slashed validator",
+		"validator_index", idx,
+		"penalty", penalty.Unwrap(),
+		"withdrawable_epoch", val.GetWithdrawableEpoch(),
+	)
+	return nil
+}
+
+// processSlashings as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-process_slashings
+//
+// NOTE: the sum of the slashings vector is tracked in the state total slashing.
+func (sp *StateProcessor) processSlashings(st *state.StateDB) error {
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if !version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+	if totalSlashing == 0 {
+		return nil
+	}
+	totalBalance, err := sp.getTotalActiveBalance(st, epoch)
+	if err != nil {
+		return err
+	}
+
+	increment := math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	adjustedTotalSlashingBalance := min(
+		totalSlashing*math.Gwei(sp.cs.ProportionalSlashingMultiplier()),
+		totalBalance,
+	)
+	penaltyPerEffectiveBalanceIncrement := adjustedTotalSlashingBalance / (totalBalance / increment)
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	slashingsEpoch := epoch + math.Epoch(sp.cs.EpochsPerSlashingsVector()/2)
+	for i, val := range vals {
+		if !val.IsSlashed() || val.GetWithdrawableEpoch() != slashingsEpoch {
+			continue
+		}
+		idx := math.ValidatorIndex(i)
+		effectiveBalanceIncrements := val.GetEffectiveBalance() / increment
+		penalty := penaltyPerEffectiveBalanceIncrement * effectiveBalanceIncrements
+		if err = st.DecreaseBalance(idx, penalty); err != nil {
+			return err
+		}
+
+		sp.logger.Info("Applied proportional slashing penalty",
+			"validator_index", idx, "penalty", penalty.Unwrap(),
+		)
+	}
+	return nil
+}
+
+// processSlashingsReset as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings-balances-updates
+func (sp *StateProcessor) processSlashingsReset(st *state.StateDB) error {
+	fork, err := st.GetFork()
+	if err != nil {
+		return err
+	}
+	if !version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	nextEpoch := sp.cs.SlotToEpoch(slot) + 1
+	index := nextEpoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if slashing == 0 {
+		return nil
+	}
+	totalSlashing, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+	if err = st.SetTotalSlashing(totalSlashing - min(totalSlashing, slashing)); err != nil {
+		return err
+	}
+	return st.SetSlashingAtIndex(index, 0)
+}
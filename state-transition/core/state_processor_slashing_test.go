@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransitionSlashMisbehavior shows that a validator reported by consensus
+// for misbehaving is slashed and exits the validator set, that the
+// proportional slashing penalty is applied halfway through its withdrawability
+// delay and that the slashings are eventually reset.
+//
+//nolint:maintidx // mostly boilerplate
+func TestTransitionSlashMisbehavior(t *testing.T) {
+	t.Parallel()
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	)
+
+	genDeposits := make(types.Deposits, 0, 3)
+	for i := range 3 {
+		genDeposits = append(genDeposits, &types.Deposit{
+			Pubkey:      [48]byte{byte(i)},
+			Credentials: credentials,
+			Amount:      maxBalance,
+			Index:       uint64(i),
+		})
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+	)
+	require.NoError(t, err)
+	depRoot := genDeposits.HashTreeRoot()
+
+	misbehavingPk := genDeposits[1].Pubkey
+	misbehaviorCtx := transition.NewTransitionCtx(
+		ctx.ConsensusCtx(),
+		0, // time
+		statetransition.DummyProposerAddr,
+	).
+		WithMisbehaviors([]transition.Misbehavior{
+			// Ignored, as the validator is unknown.
+			{ValidatorAddress: []byte{0xff}, Height: 1},
+			{ValidatorAddress: cmtcrypto.AddressHash(misbehavingPk[:]), Height: 1},
+			// Ignored, as the validator has already been slashed.
+			{ValidatorAddress: cmtcrypto.AddressHash(misbehavingPk[:]), Height: 1},
+		}).
+		WithVerifyPayload(false).
+		WithVerifyRandao(false).
+		WithVerifyResult(false).
+		WithMeterGas(false)
+
+	blk := buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), 10, []*types.Deposit{}, st.EVMInflationWithdrawal(10),
+	)
+	_, err = sp.Transition(misbehaviorCtx, st, blk)
+	require.NoError(t, err)
+
+	// The validator is slashed, exits and pays the initial penalty.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+	require.Equal(t, math.Epoch(1), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(cs.EpochsPerSlashingsVector()), val.GetWithdrawableEpoch())
+
+	initialPenalty := maxBalance / math.Gwei(cs.MinSlashingPenaltyQuotient())
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-initialPenalty, balance)
+
+	slashing, err := st.GetSlashingAtIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, slashing)
+	totalSlashing, err := st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, totalSlashing)
+
+	// The slashed validator is evicted from the validator set at the next epoch.
+	progressStateToSlot(t, st, math.Slot(cs.SlotsPerEpoch()-1))
+	timestamp := math.U64(11)
+	withdrawals := expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	valUpdates, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		{Pubkey: misbehavingPk, EffectiveBalance: 0},
+	}, valUpdates)
+
+	// The proportional slashing penalty is applied halfway through the
+	// withdrawability delay, when processing the end of that epoch.
+	slashingsEpoch := cs.EpochsPerSlashingsVector() / 2
+	progressStateToSlot(t, st, math.Slot((slashingsEpoch+1)*cs.SlotsPerEpoch()-1))
+	val, err = st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	balance, err = st.GetBalance(1)
+	require.NoError(t, err)
+
+	totalActiveBalance := 2 * maxBalance
+	adjustedTotalSlashing := min(
+		totalSlashing*math.Gwei(cs.ProportionalSlashingMultiplier()), totalActiveBalance,
+	)
+	proportionalPenalty := adjustedTotalSlashing / (totalActiveBalance / increment) *
+		(val.GetEffectiveBalance() / increment)
+	require.NotZero(t, proportionalPenalty)
+
+	timestamp = math.U64(12)
+	withdrawals = expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	balanceAfter, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, balance-proportionalPenalty, balanceAfter)
+
+	// The slashing is reset once the slashings vector wraps around.
+	progressStateToSlot(t, st, math.Slot(cs.EpochsPerSlashingsVector()*cs.SlotsPerEpoch()-1))
+	timestamp = math.U64(13)
+	withdrawals = expectedWithdrawalsAtNextSlot(t, sp, st, ctx, timestamp)
+	blk = buildNextBlock(
+		t, cs, st, types.NewEth1Data(depRoot), timestamp, []*types.Deposit{}, withdrawals...,
+	)
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	slashing, err = st.GetSlashingAtIndex(0)
+	require.NoError(t, err)
+	require.Zero(t, slashing)
+	totalSlashing, err = st.GetTotalSlashing()
+	require.NoError(t, err)
+	require.Zero(t, totalSlashing)
+}
@@ -37,24 +37,46 @@ func (sp *StateProcessor) processOperations(
 	st *state.StateDB,
 	blk *ctypes.BeaconBlock,
 ) error {
+	deposits := blk.GetBody().GetDeposits()
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	depositIndexLimit, err := st.Eth1DepositIndexLimit()
+	if err != nil {
+		return err
+	}
+
+	// Once the legacy deposits preceding the first EIP-6110 deposit request are
+	// drained, deposits are only processed from the execution requests.
+	if depositIndex >= depositIndexLimit {
+		if len(deposits) > 0 {
+			return errors.Wrapf(
+				ErrUnexpectedLegacyDeposits, "deposit requests start index: %d, got: %d deposits",
+				depositIndexLimit, len(deposits),
+			)
+		}
+		return st.SetEth1Data(blk.GetBody().Eth1Data)
+	}
+
 	// Verify that outstanding deposits are processed up to the maximum number of deposits.
 	//
 	// Unlike Eth 2.0 specs we don't check that
-	// `len(body.deposits) ==  min(MAX_DEPOSITS, state.eth1_data.deposit_count - state.eth1_deposit_index)`
-	deposits := blk.GetBody().GetDeposits()
-	if uint64(len(deposits)) > sp.cs.MaxDepositsPerBlock() {
+	// `len(body.deposits) ==  min(MAX_DEPOSITS, eth1_deposit_index_limit - state.eth1_deposit_index)`
+	maxDeposits := min(sp.cs.MaxDepositsPerBlock(), depositIndexLimit-depositIndex)
+	if uint64(len(deposits)) > maxDeposits {
 		return errors.Wrapf(
 			ErrExceedsBlockDepositLimit, "expected: %d, got: %d",
-			sp.cs.MaxDepositsPerBlock(), len(deposits),
+			maxDeposits, len(deposits),
 		)
 	}
 
 	// Instead we directly compare block deposits with our local store ones.
-	if err := ValidateNonGenesisDeposits(
+	if err = ValidateNonGenesisDeposits(
 		ctx.ConsensusCtx(),
 		st,
 		sp.ds,
-		sp.cs.MaxDepositsPerBlock(),
+		maxDeposits,
 		deposits,
 		blk.GetBody().GetEth1Data().DepositRoot,
 	); err != nil {
@@ -62,7 +84,7 @@ func (sp *StateProcessor) processOperations(
 	}
 
 	for _, dep := range deposits {
-		if err := sp.processDeposit(st, dep); err != nil {
+		if err = sp.processDeposit(st, dep); err != nil {
 			return err
 		}
 	}
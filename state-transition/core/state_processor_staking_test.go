@@ -86,6 +86,7 @@ func TestTransitionUpdateValidators(t *testing.T) {
 		genDeposits,
 		genPayloadHeader,
 		cs.GenesisForkVersion(),
+		nil,
 	)
 	require.NoError(t, err)
 	require.Len(t, valDiff, len(genDeposits))
@@ -212,6 +213,7 @@ func TestTransitionCreateValidator(t *testing.T) {
 		genDeposits,
 		genPayloadHeader,
 		cs.GenesisForkVersion(),
+		nil,
 	)
 	require.NoError(t, err)
 	require.Len(t, genVals, len(genDeposits))
@@ -386,7 +388,7 @@ func TestTransitionWithdrawals(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err := sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -472,7 +474,7 @@ func TestTransitionMaxWithdrawals(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err = sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -613,6 +615,7 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 		genDeposits,
 		genPayloadHeader,
 		cs.GenesisForkVersion(),
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -735,8 +738,10 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 	// for rejected validator are enqueued then
 	blk = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
 
-	// finally the block turning epoch. extra validator deposits
-	// will be withdrawn within 3 blocks (#Validator / MaxValidatorsPerWithdrawalsSweep)
+	// finally the block turning epoch. The withdrawals sweep visits
+	// MaxValidatorsPerWithdrawalsSweep validators per block, advancing at
+	// every block since genesis, so it reaches the extra validator in the
+	// block following the one turning epoch.
 	extraValAddr, err := extraValCreds.ToExecutionAddress()
 	require.NoError(t, err)
 	blk = buildNextBlock(
@@ -751,18 +756,6 @@ func TestTransitionHittingValidatorsCap_ExtraSmall(t *testing.T) {
 	_, err = sp.Transition(ctx, st, blk)
 	require.NoError(t, err)
 
-	blk = buildNextBlock(
-		t,
-		cs,
-		st,
-		types.NewEth1Data(depRoot),
-		blk.GetTimestamp()+1,
-		[]*types.Deposit{},
-		st.EVMInflationWithdrawal(blk.GetTimestamp()+1),
-	)
-	_, err = sp.Transition(ctx, st, blk)
-	require.NoError(t, err)
-
 	withdrawals := []*engineprimitives.Withdrawal{
 		st.EVMInflationWithdrawal(blk.GetTimestamp() + 1),
 		{
@@ -839,6 +832,7 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 		genDeposits,
 		genPayloadHeader,
 		cs.GenesisForkVersion(),
+		nil,
 	)
 	require.NoError(t, err)
 	require.Len(t, genVals, len(genDeposits))
@@ -1016,28 +1010,16 @@ func TestTransitionHittingValidatorsCap_ExtraBig(t *testing.T) {
 	require.Equal(t, constants.GenesisEpoch+3, smallestVal.WithdrawableEpoch)
 
 	// STEP 4: move the chain to the next epoch and show withdrawal
-	// for rejected validator is enqueued within 3 blocks
-	// (#Validator / MaxValidatorsPerWithdrawalsSweep)
-	_ = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
+	// for rejected validator is enqueued in the block following the one
+	// turning epoch, as the withdrawals sweep visits
+	// MaxValidatorsPerWithdrawalsSweep validators per block
+	blk = moveToEndOfEpoch(t, blk, cs, sp, st, ctx, depRoot)
 
 	valToEvict := genDeposits[0]
 	valToEvictAddr, err := valToEvict.Credentials.ToExecutionAddress()
 	require.NoError(t, err)
 
 	// finally the block turning epoch
-	blk = buildNextBlock(
-		t,
-		cs,
-		st,
-		types.NewEth1Data(depRoot),
-		blk.GetTimestamp()+1,
-		[]*types.Deposit{},
-		st.EVMInflationWithdrawal(blk.GetTimestamp()+1),
-	)
-
-	_, err = sp.Transition(ctx, st, blk)
-	require.NoError(t, err)
-
 	blk = buildNextBlock(
 		t,
 		cs,
@@ -1099,7 +1081,7 @@ func TestValidatorNotWithdrawable(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err := sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
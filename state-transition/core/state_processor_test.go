@@ -57,7 +57,7 @@ func TestStateProcessor_ProcessSlots(t *testing.T) {
 	)
 	genPayloadHeader.Timestamp = math.U64(genesisTime.Unix())
 	_, err := sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
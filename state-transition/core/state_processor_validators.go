@@ -27,8 +27,10 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/sourcegraph/conc/iter"
 )
@@ -44,6 +46,11 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 		return fmt.Errorf("registry update, failed listing validators: %w", err)
 	}
 
+	fork, err := st.GetFork()
+	if err != nil {
+		return fmt.Errorf("registry update, failed loading fork: %w", err)
+	}
+
 	currEpoch := sp.cs.SlotToEpoch(slot)
 	nextEpoch := currEpoch + 1
 
@@ -51,7 +58,7 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 		sp.cs.EjectionBalance() + sp.cs.EffectiveBalanceIncrement(),
 	)
 
-	// We do not currently have a cap on validator churn,
+	// We do not currently have a cap on validator activation churn,
 	// so we can process validators activations in a single loop
 	var idx math.ValidatorIndex
 	for si, val := range vals {
@@ -64,22 +71,39 @@ func (sp *StateProcessor) processRegistryUpdates(st *statedb.StateDB) error {
 			val.SetActivationEpoch(nextEpoch)
 			valModified = true
 		}
-		// Note: without slashing and voluntary withdrawals, there is no way
-		// for an activa validator to have its balance less or equal to
-		// EjectionBalance
 
+		// From Electra onwards slashings and partial withdrawals may bring the
+		// effective balance of an active validator down to EjectionBalance, in
+		// which case the validator is queued for exit.
+		isEjectable := version.EqualsOrIsAfter(fork.CurrentVersion, version.Electra()) &&
+			val.IsActive(currEpoch) &&
+			val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) &&
+			val.GetEffectiveBalance() <= math.Gwei(sp.cs.EjectionBalance())
+
+		if !valModified && !isEjectable {
+			continue
+		}
+		idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
+		if err != nil {
+			return fmt.Errorf(
+				"registry update, failed loading validator index, state index %d: %w",
+				si,
+				err,
+			)
+		}
 		if valModified {
-			idx, err = st.ValidatorIndexByPubkey(val.GetPubkey())
-			if err != nil {
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
 				return fmt.Errorf(
-					"registry update, failed loading validator index, state index %d: %w",
-					si,
+					"registry update, failed updating validator idx %d: %w",
+					idx,
 					err,
 				)
 			}
-			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		}
+		if isEjectable {
+			if err = sp.initiateValidatorExit(st, idx); err != nil {
 				return fmt.Errorf(
-					"registry update, failed updating validator idx %d: %w",
+					"registry update, failed ejecting validator idx %d: %w",
 					idx,
 					err,
 				)
@@ -140,8 +164,9 @@ func (sp *StateProcessor) processValidatorSetCap(st *statedb.StateDB) error {
 		}
 	})
 
-	// We do not currently have a cap on validators churn, so we stop
-	// validators next epoch and we withdraw them the epoch after
+	// The validator set cap must hold next epoch, so evictions bypass the
+	// exit churn: we stop validators next epoch and we withdraw them the
+	// epoch after
 	var idx math.ValidatorIndex
 	for li := range uint64(len(nextEpochVals)) - validatorSetCap {
 		valToEject := nextEpochVals[li]
@@ -166,6 +191,50 @@ func (sp *StateProcessor) processValidatorSetCap(st *statedb.StateDB) error {
 	return nil
 }
 
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-initiate_validator_exit
+//
+// The exit is queued according to the exit churn, and the validator becomes
+// withdrawable MinValidatorWithdrawabilityDelay epochs after exiting.
+func (sp *StateProcessor) initiateValidatorExit(
+	st *statedb.StateDB, idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator already initiated exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	exitQueueEpoch, err := sp.computeExitEpochAndUpdateChurn(
+		st, sp.cs.SlotToEpoch(slot), val.GetEffectiveBalance(),
+	)
+	if err != nil {
+		return err
+	}
+	withdrawableEpoch := exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay())
+
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(withdrawableEpoch)
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return fmt.Errorf("exit, failed updating validator idx %d: %w", idx, err)
+	}
+
+	sp.logger.Info("Initiated validator exit",
+		"validator_index", idx,
+		"exit_epoch", exitQueueEpoch,
+		"withdrawable_epoch", withdrawableEpoch,
+	)
+	return nil
+}
+
 // Note: validatorSetsDiffs does not need to be a StateProcessor method
 // but it helps simplifying generic instantiation.
 func validatorSetsDiffs(
@@ -235,3 +304,19 @@ func getActiveVals(st *statedb.StateDB, epoch math.Epoch) ([]*ctypes.Validator,
 	}
 	return activeVals, nil
 }
+
+// getTotalActiveBalance as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_total_active_balance
+func (sp *StateProcessor) getTotalActiveBalance(
+	st *statedb.StateDB, epoch math.Epoch,
+) (math.Gwei, error) {
+	activeVals, err := getActiveVals(st, epoch)
+	if err != nil {
+		return 0, err
+	}
+	var total math.Gwei
+	for _, val := range activeVals {
+		total += val.GetEffectiveBalance()
+	}
+	return max(math.Gwei(sp.cs.EffectiveBalanceIncrement()), total), nil
+}
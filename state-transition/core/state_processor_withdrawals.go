@@ -36,6 +36,7 @@ import (
 // 1. The first withdrawal MUST be a fixed EVM inflation withdrawal
 // 2. Subsequent withdrawals (if any) are processed as validator withdrawals
 // 3. This modification reduces the maximum validator withdrawals per block by one.
+// 4. From Electra onwards, processed pending partial withdrawals are dequeued.
 //
 
 func (sp *StateProcessor) processWithdrawals(
@@ -49,7 +50,7 @@ func (sp *StateProcessor) processWithdrawals(
 	)
 
 	// Get the expected withdrawals.
-	expectedWithdrawals, err := st.ExpectedWithdrawals(blk.GetTimestamp())
+	expectedWithdrawals, processedPartialWithdrawals, err := st.ExpectedWithdrawals(blk.GetTimestamp())
 	if err != nil {
 		return err
 	}
@@ -92,6 +93,20 @@ func (sp *StateProcessor) processWithdrawals(
 		}
 	}
 
+	// Update the pending partial withdrawals queue.
+	if processedPartialWithdrawals > 0 {
+		var pendingPartialWithdrawals []*ctypes.PendingPartialWithdrawal
+		pendingPartialWithdrawals, err = st.GetPendingPartialWithdrawals()
+		if err != nil {
+			return err
+		}
+		if err = st.SetPendingPartialWithdrawals(
+			pendingPartialWithdrawals[processedPartialWithdrawals:],
+		); err != nil {
+			return err
+		}
+	}
+
 	if numWithdrawals > 1 {
 		if err = st.SetNextWithdrawalIndex(
 			(expectedWithdrawals[numWithdrawals-1].GetIndex() + 1).Unwrap(),
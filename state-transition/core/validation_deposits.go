@@ -70,6 +70,57 @@ func validateGenesisDeposits(
 	return nil
 }
 
+// validateGenesisRestart checks that the deposits of an exported genesis are
+// all the deposits included in the exported chain, and that its restart state
+// is consistent with its validator registry.
+func validateGenesisRestart(deposits ctypes.Deposits, restart *ctypes.GenesisRestart) error {
+	if uint64(len(deposits)) != restart.Eth1DepositIndex {
+		return errors.Wrapf(ErrDepositsLengthMismatch,
+			"genesis deposits count: %d, exported eth1 deposit index: %d",
+			len(deposits), restart.Eth1DepositIndex,
+		)
+	}
+	for i, deposit := range deposits {
+		// #nosec G115
+		if deposit.GetIndex() != math.U64(i) {
+			return errors.Wrapf(ErrDepositIndexOutOfOrder,
+				"genesis deposit index: %d, expected index: %d", deposit.GetIndex().Unwrap(), i,
+			)
+		}
+	}
+	if !deposits.HashTreeRoot().Equals(restart.DepositRoot) {
+		return errors.Wrap(ErrDepositsRootMismatch, "genesis deposits do not match the exported deposit root")
+	}
+
+	numValidators := uint64(len(restart.Validators))
+	switch {
+	case numValidators == 0:
+		return errors.Wrap(ErrInvalidGenesisRestart, "at least one validator should be restored")
+	case uint64(len(restart.Balances)) != numValidators:
+		return errors.Wrapf(ErrInvalidGenesisRestart,
+			"%d balances for %d validators", len(restart.Balances), numValidators,
+		)
+	case restart.NextWithdrawalValidatorIndex.Unwrap() >= numValidators:
+		return errors.Wrapf(ErrInvalidGenesisRestart,
+			"next withdrawal validator index %d out of %d validators",
+			restart.NextWithdrawalValidatorIndex, numValidators,
+		)
+	}
+	for i, val := range restart.Validators {
+		if val == nil {
+			return errors.Wrapf(ErrInvalidGenesisRestart, "validator %d is nil", i)
+		}
+	}
+	for _, withdrawal := range restart.PendingPartialWithdrawals {
+		if withdrawal.ValidatorIndex.Unwrap() >= numValidators {
+			return errors.Wrapf(ErrInvalidGenesisRestart,
+				"pending partial withdrawal of unknown validator %d", withdrawal.ValidatorIndex,
+			)
+		}
+	}
+	return nil
+}
+
 func ValidateNonGenesisDeposits(
 	ctx context.Context,
 	st *statedb.StateDB,
@@ -128,3 +179,31 @@ func ValidateNonGenesisDeposits(
 
 	return nil
 }
+
+// ValidateDepositStore checks that the deposit store is in sync with the
+// given state, i.e. that it holds exactly the deposits included so far and
+// that they hash to the state's deposit root.
+func ValidateDepositStore(
+	ctx context.Context,
+	st *statedb.StateDB,
+	depositStore *depositdb.KVStore,
+) error {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	return ValidateNonGenesisDeposits(
+		ctx,
+		st,
+		depositStore,
+		// maxDepositsPerBlock: 0
+		// In this snapshotted state, we will check up to the existing deposits and not any more.
+		0,
+		// blkDeposits: nil
+		// There are no new block deposits as we are checking at this snapshotted state.
+		nil,
+		// blkDepositRoot: eth1Data.DepositRoot
+		// We will compare against the beacon state's deposit root at this snapshotted state.
+		eth1Data.DepositRoot,
+	)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/state-transition/core"
 	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
 	"github.com/stretchr/testify/require"
 )
@@ -65,7 +66,7 @@ func TestInvalidDeposits(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err := sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -132,7 +133,7 @@ func TestInvalidDepositsCount(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err := sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -202,7 +203,7 @@ func TestLocalDepositsExceedBlockDeposits(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err = sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -272,7 +273,7 @@ func TestLocalDepositsExceedBlockDepositsBadRoot(t *testing.T) {
 	)
 	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
 	_, err = sp.InitializeBeaconStateFromEth1(
-		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
 	)
 	require.NoError(t, err)
 
@@ -314,3 +315,97 @@ func TestLocalDepositsExceedBlockDepositsBadRoot(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "deposits root mismatch")
 }
+
+// TestTruncatedDepositStore shows that truncating the deposit store from the
+// deposit index of the state brings it back in sync with the state, as done on
+// rollback, while truncating below it or re-enqueuing other deposits does not.
+func TestTruncatedDepositStore(t *testing.T) {
+	t.Parallel()
+	credentials0 := types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{})
+	newDeposit := func(index uint64, amount math.Gwei) *types.Deposit {
+		return &types.Deposit{
+			Pubkey:      [48]byte{byte(index)},
+			Credentials: credentials0,
+			Amount:      amount,
+			Index:       index,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		truncateFrom uint64
+		reEnqueued   types.Deposits
+		wantStored   int
+		expectedErr  error
+	}{
+		{
+			name:         "deposits ahead of the state removed",
+			truncateFrom: 3,
+			wantStored:   3,
+		},
+		{
+			name:         "truncation past the stored deposits",
+			truncateFrom: 10,
+			wantStored:   5,
+		},
+		{
+			name:         "truncation below the deposit index",
+			truncateFrom: 1,
+			wantStored:   1,
+			expectedErr:  core.ErrDepositsLengthMismatch,
+		},
+		{
+			name:         "truncation of every deposit",
+			truncateFrom: 0,
+			wantStored:   0,
+			expectedErr:  core.ErrDepositsLengthMismatch,
+		},
+		{
+			name:         "different deposit re-enqueued below the deposit index",
+			truncateFrom: 2,
+			reEnqueued:   types.Deposits{newDeposit(2, 1)},
+			wantStored:   3,
+			expectedErr:  core.ErrDepositsRootMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cs := setupChain(t)
+			sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+			maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+
+			// The state includes 3 deposits while the store holds 2 more, as
+			// after rolling back the state past the block including them.
+			genDeposits := types.Deposits{
+				newDeposit(0, maxBalance), newDeposit(1, maxBalance), newDeposit(2, maxBalance),
+			}
+			genPayloadHeader := &types.ExecutionPayloadHeader{
+				Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+			}
+			require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+			_, err := sp.InitializeBeaconStateFromEth1(
+				st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(), nil,
+			)
+			require.NoError(t, err)
+			require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), types.Deposits{
+				newDeposit(3, maxBalance), newDeposit(4, maxBalance),
+			}))
+
+			require.NoError(t, ds.Truncate(ctx.ConsensusCtx(), tt.truncateFrom))
+			require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), tt.reEnqueued))
+
+			stored, err := ds.GetDepositsByIndex(ctx.ConsensusCtx(), 0, 10)
+			require.NoError(t, err)
+			require.Len(t, stored, tt.wantStored)
+
+			err = core.ValidateDepositStore(ctx.ConsensusCtx(), st, ds)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
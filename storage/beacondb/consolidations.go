@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"errors"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetPendingConsolidations is equivalent to `pending_consolidations`.
+// If called before electra, will return an error.
+func (kv *KVStore) GetPendingConsolidations() ([]*ctypes.PendingConsolidation, error) {
+	pendingConsolidations, err := kv.pendingConsolidations.Get(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pendingConsolidations == nil {
+		return nil, errors.New("unexpected nil pending consolidations")
+	}
+	return *pendingConsolidations, nil
+}
+
+// SetPendingConsolidations sets the pending consolidations.
+func (kv *KVStore) SetPendingConsolidations(pendingConsolidations []*ctypes.PendingConsolidation) error {
+	pc := ctypes.PendingConsolidations(pendingConsolidations)
+	return kv.pendingConsolidations.Set(kv.ctx, &pc)
+}
+
+// GetConsolidationBalanceToConsume is equivalent to `consolidation_balance_to_consume`.
+func (kv *KVStore) GetConsolidationBalanceToConsume() (math.Gwei, error) {
+	balance, err := kv.consolidationBalanceToConsume.Get(kv.ctx)
+	return math.Gwei(balance), err
+}
+
+// SetConsolidationBalanceToConsume sets the consolidation balance to consume.
+func (kv *KVStore) SetConsolidationBalanceToConsume(balance math.Gwei) error {
+	return kv.consolidationBalanceToConsume.Set(kv.ctx, balance.Unwrap())
+}
+
+// GetEarliestConsolidationEpoch is equivalent to `earliest_consolidation_epoch`.
+func (kv *KVStore) GetEarliestConsolidationEpoch() (math.Epoch, error) {
+	epoch, err := kv.earliestConsolidationEpoch.Get(kv.ctx)
+	return math.Epoch(epoch), err
+}
+
+// SetEarliestConsolidationEpoch sets the earliest consolidation epoch.
+func (kv *KVStore) SetEarliestConsolidationEpoch(epoch math.Epoch) error {
+	return kv.earliestConsolidationEpoch.Set(kv.ctx, epoch.Unwrap())
+}
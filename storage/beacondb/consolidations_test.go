@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingConsolidations_Nil verifies that if no pending consolidations
+// have been set, then GetPendingConsolidations returns an error.
+func TestPendingConsolidations_Nil(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	pc, err := store.GetPendingConsolidations()
+	require.ErrorContains(t, err, "collections: not found")
+	require.Nil(t, pc)
+}
+
+// TestPendingConsolidations_SetAndGet verifies that pending consolidations
+// round trip through the store and can be drained.
+func TestPendingConsolidations_SetAndGet(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	consolidations := []*types.PendingConsolidation{
+		{SourceIndex: math.U64(1), TargetIndex: math.U64(2)},
+		{SourceIndex: math.U64(3), TargetIndex: math.U64(2)},
+	}
+	require.NoError(t, store.SetPendingConsolidations(consolidations))
+	pc, err := store.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, consolidations, pc)
+
+	require.NoError(t, store.SetPendingConsolidations(consolidations[1:]))
+	pc, err = store.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, consolidations[1:], pc)
+}
+
+// TestConsolidationChurn_SetAndGet verifies the consolidation churn
+// bookkeeping fields round trip through the store.
+func TestConsolidationChurn_SetAndGet(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	require.NoError(t, store.SetConsolidationBalanceToConsume(math.Gwei(42)))
+	require.NoError(t, store.SetEarliestConsolidationEpoch(math.Epoch(7)))
+
+	balance, err := store.GetConsolidationBalanceToConsume()
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(42), balance)
+	epoch, err := store.GetEarliestConsolidationEpoch()
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(7), epoch)
+}
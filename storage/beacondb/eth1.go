@@ -21,6 +21,8 @@
 package beacondb
 
 import (
+	"errors"
+
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 )
@@ -67,6 +69,38 @@ func (kv *KVStore) SetEth1DepositIndex(index uint64) error {
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
+// GetDepositRequestsStartIndex retrieves the index of the first EIP-6110
+// deposit request from the beacon state. If called before electra, will
+// return an error.
+func (kv *KVStore) GetDepositRequestsStartIndex() (uint64, error) {
+	return kv.depositRequestsStartIndex.Get(kv.ctx)
+}
+
+// SetDepositRequestsStartIndex sets the index of the first EIP-6110 deposit
+// request in the beacon state.
+func (kv *KVStore) SetDepositRequestsStartIndex(index uint64) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
+// GetPendingDeposits is equivalent to `pending_deposits`.
+// If called before electra, will return an error.
+func (kv *KVStore) GetPendingDeposits() ([]*ctypes.Deposit, error) {
+	pendingDeposits, err := kv.pendingDeposits.Get(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pendingDeposits == nil {
+		return nil, errors.New("unexpected nil pending deposits")
+	}
+	return *pendingDeposits, nil
+}
+
+// SetPendingDeposits sets the pending deposits.
+func (kv *KVStore) SetPendingDeposits(pendingDeposits []*ctypes.Deposit) error {
+	pd := ctypes.PendingDeposits(pendingDeposits)
+	return kv.pendingDeposits.Set(kv.ctx, &pd)
+}
+
 // GetEth1Data retrieves the eth1 data from the beacon state.
 func (kv *KVStore) GetEth1Data() (*ctypes.Eth1Data, error) {
 	return kv.eth1Data.Get(kv.ctx)
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingDeposits_Nil verifies that if no pending deposits have been set,
+// then GetPendingDeposits returns an error.
+func TestPendingDeposits_Nil(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	pd, err := store.GetPendingDeposits()
+	require.ErrorContains(t, err, "collections: not found")
+	require.Nil(t, pd)
+}
+
+// TestPendingDeposits_SetAndGet verifies that pending deposits round trip
+// through the store and can be drained.
+func TestPendingDeposits_SetAndGet(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	deposits := []*types.Deposit{
+		{Pubkey: [48]byte{0x01}, Amount: math.Gwei(32e9), Index: 2},
+		{Pubkey: [48]byte{0x02}, Amount: math.Gwei(1e9), Index: 3},
+	}
+	require.NoError(t, store.SetPendingDeposits(deposits))
+	pd, err := store.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Equal(t, deposits, pd)
+
+	require.NoError(t, store.SetPendingDeposits([]*types.Deposit{}))
+	pd, err = store.GetPendingDeposits()
+	require.NoError(t, err)
+	require.Empty(t, pd)
+}
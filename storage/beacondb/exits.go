@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetExitBalanceToConsume is equivalent to `exit_balance_to_consume`.
+func (kv *KVStore) GetExitBalanceToConsume() (math.Gwei, error) {
+	balance, err := kv.exitBalanceToConsume.Get(kv.ctx)
+	return math.Gwei(balance), err
+}
+
+// SetExitBalanceToConsume sets the exit balance to consume.
+func (kv *KVStore) SetExitBalanceToConsume(balance math.Gwei) error {
+	return kv.exitBalanceToConsume.Set(kv.ctx, balance.Unwrap())
+}
+
+// GetEarliestExitEpoch is equivalent to `earliest_exit_epoch`.
+func (kv *KVStore) GetEarliestExitEpoch() (math.Epoch, error) {
+	epoch, err := kv.earliestExitEpoch.Get(kv.ctx)
+	return math.Epoch(epoch), err
+}
+
+// SetEarliestExitEpoch sets the earliest exit epoch.
+func (kv *KVStore) SetEarliestExitEpoch(epoch math.Epoch) error {
+	return kv.earliestExitEpoch.Set(kv.ctx, epoch.Unwrap())
+}
@@ -45,6 +45,15 @@ const (
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
 	PendingPartialWithdrawalsPrefix
+	ConsolidationBalanceToConsumePrefix
+	EarliestConsolidationEpochPrefix
+	PendingConsolidationsPrefix
+	DepositRequestsStartIndexPrefix
+	MissedSignaturesPrefix
+	ExitBalanceToConsumePrefix
+	EarliestExitEpochPrefix
+	PendingDepositsPrefix
+	MissedSignaturesTotalPrefix
 )
 
 const (
@@ -72,4 +81,13 @@ const (
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
 	PendingPartialWithdrawalsPrefixHumanReadable        = "PendingPartialWithdrawalsPrefix"
+	ConsolidationBalanceToConsumePrefixHumanReadable    = "ConsolidationBalanceToConsumePrefix"
+	EarliestConsolidationEpochPrefixHumanReadable       = "EarliestConsolidationEpochPrefix"
+	PendingConsolidationsPrefixHumanReadable            = "PendingConsolidationsPrefix"
+	DepositRequestsStartIndexPrefixHumanReadable        = "DepositRequestsStartIndexPrefix"
+	MissedSignaturesPrefixHumanReadable                 = "MissedSignaturesPrefix"
+	ExitBalanceToConsumePrefixHumanReadable             = "ExitBalanceToConsumePrefix"
+	EarliestExitEpochPrefixHumanReadable                = "EarliestExitEpochPrefix"
+	PendingDepositsPrefixHumanReadable                  = "PendingDepositsPrefix"
+	MissedSignaturesTotalPrefixHumanReadable            = "MissedSignaturesTotalPrefix"
 )
@@ -21,12 +21,14 @@
 package beacondb
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/storage"
 	"github.com/berachain/beacon-kit/storage/beacondb/index"
 	"github.com/berachain/beacon-kit/storage/beacondb/keys"
 	"github.com/berachain/beacon-kit/storage/encoding"
@@ -37,6 +39,8 @@ import (
 // that provides access to all beacon related data.
 type KVStore struct {
 	ctx context.Context
+	// kss is the service the store is opened with.
+	kss store.KVStoreService
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
@@ -97,6 +101,38 @@ type KVStore struct {
 	// We must use `*ctypes.PendingPartialWithdrawals` instead of `ctypes.PendingPartialWithdrawals` as marshalling
 	// methods require a pointer receiver.
 	pendingPartialWithdrawals sdkcollections.Item[*ctypes.PendingPartialWithdrawals]
+	// consolidationBalanceToConsume stores the consolidation churn left over
+	// for the epoch stored in earliestConsolidationEpoch.
+	consolidationBalanceToConsume sdkcollections.Item[uint64]
+	// earliestConsolidationEpoch stores the earliest epoch at which a new
+	// consolidation can be processed.
+	earliestConsolidationEpoch sdkcollections.Item[uint64]
+	// pendingConsolidations stores the PendingConsolidations introduced in Electra.
+	// As for pendingPartialWithdrawals, the queue is only ever appended to and
+	// drained from the front, so it is stored as a single list `Item`.
+	pendingConsolidations sdkcollections.Item[*ctypes.PendingConsolidations]
+	// depositRequestsStartIndex stores the index of the first EIP-6110 deposit
+	// request processed, introduced in Electra.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
+	// exitBalanceToConsume stores the exit churn left over for the epoch
+	// stored in earliestExitEpoch.
+	exitBalanceToConsume sdkcollections.Item[uint64]
+	// earliestExitEpoch stores the earliest epoch at which a new exit can be
+	// processed.
+	earliestExitEpoch sdkcollections.Item[uint64]
+	// pendingDeposits stores the EIP-6110 deposit requests waiting for the
+	// legacy deposits to be drained, introduced in Electra. As for
+	// pendingConsolidations, it is stored as a single list `Item`.
+	pendingDeposits sdkcollections.Item[*ctypes.PendingDeposits]
+	// Liveness
+	// missedSignatures stores the number of blocks each validator did not
+	// sign, keyed by the epoch index in the liveness window and by the
+	// validator index. It is not part of the beacon state.
+	missedSignatures sdkcollections.Map[sdkcollections.Pair[uint64, uint64], uint64]
+	// missedSignaturesTotal stores the number of blocks each validator did not
+	// sign over the whole liveness window, keyed by the validator index. It is
+	// kept in sync with missedSignatures.
+	missedSignaturesTotal sdkcollections.Map[uint64, uint64]
 }
 
 // New creates a new instance of Store.
@@ -112,6 +148,7 @@ func New(kss store.KVStoreService) *KVStore {
 
 	res := &KVStore{
 		ctx: nil, // set by WithContext or Copy
+		kss: kss,
 		genesisValidatorsRoot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.GenesisValidatorsRootPrefix}),
@@ -251,6 +288,79 @@ func New(kss store.KVStoreService) *KVStore {
 				NewEmptyF: ctypes.NewEmptyPendingPartialWithdrawals,
 			},
 		),
+		consolidationBalanceToConsume: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.ConsolidationBalanceToConsumePrefix},
+			),
+			keys.ConsolidationBalanceToConsumePrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		earliestConsolidationEpoch: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.EarliestConsolidationEpochPrefix},
+			),
+			keys.EarliestConsolidationEpochPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingConsolidations: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingConsolidationsPrefix}),
+			keys.PendingConsolidationsPrefixHumanReadable,
+			encoding.SSZValueCodec[*ctypes.PendingConsolidations]{
+				NewEmptyF: ctypes.NewEmptyPendingConsolidations,
+			},
+		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		exitBalanceToConsume: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.ExitBalanceToConsumePrefix},
+			),
+			keys.ExitBalanceToConsumePrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		earliestExitEpoch: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.EarliestExitEpochPrefix},
+			),
+			keys.EarliestExitEpochPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		pendingDeposits: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingDepositsPrefix}),
+			keys.PendingDepositsPrefixHumanReadable,
+			encoding.SSZValueCodec[*ctypes.PendingDeposits]{
+				NewEmptyF: ctypes.NewEmptyPendingDeposits,
+			},
+		),
+		missedSignatures: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.MissedSignaturesPrefix}),
+			keys.MissedSignaturesPrefixHumanReadable,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key,
+				sdkcollections.Uint64Key,
+			),
+			sdkcollections.Uint64Value,
+		),
+		missedSignaturesTotal: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.MissedSignaturesTotalPrefix}),
+			keys.MissedSignaturesTotalPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 	}
 	if _, err := schemaBuilder.Build(); err != nil {
 		panic(fmt.Errorf("failed building KVStore schema: %w", err))
@@ -267,6 +377,27 @@ func (kv *KVStore) Copy(ctx context.Context) *KVStore {
 	return ss
 }
 
+// Snapshot returns a copy of the Store backed by an in-memory store holding
+// all its current entries. Unlike Copy, the snapshot does not read through to
+// the underlying multistore, so later writes and commits to it are not seen.
+// NOTE: the snapshot is not isolated from a Copy of itself.
+func (kv *KVStore) Snapshot() (*KVStore, error) {
+	iter, err := kv.kss.OpenKVStore(kv.ctx).Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	kss := storage.NewMemKVStoreService()
+	dst := kss.OpenKVStore(kv.ctx)
+	for ; iter.Valid(); iter.Next() {
+		if err = dst.Set(bytes.Clone(iter.Key()), bytes.Clone(iter.Value())); err != nil {
+			return nil, err
+		}
+	}
+	return New(kss).WithContext(kv.ctx), nil
+}
+
 // Context returns the context of the Store.
 func (kv *KVStore) Context() context.Context {
 	return kv.ctx
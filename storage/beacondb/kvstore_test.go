@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshot verifies that a snapshot holds the entries of the store at the
+// time it is taken and is detached from it afterwards.
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	require.NoError(t, store.SetSlot(math.Slot(1)))
+	require.NoError(t, store.SetBalance(math.ValidatorIndex(0), math.Gwei(10)))
+
+	snapshot, err := store.Snapshot()
+	require.NoError(t, err)
+
+	// Writes to the store are not seen by the snapshot.
+	require.NoError(t, store.SetSlot(math.Slot(2)))
+	slot, err := snapshot.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+	balance, err := snapshot.GetBalance(math.ValidatorIndex(0))
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(10), balance)
+
+	// Writes to the snapshot are not seen by the store.
+	require.NoError(t, snapshot.SetBalance(math.ValidatorIndex(0), math.Gwei(20)))
+	balance, err = store.GetBalance(math.ValidatorIndex(0))
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(10), balance)
+}
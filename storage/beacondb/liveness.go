@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetMissedSignatures retrieves the number of blocks the validator did not
+// sign in the given liveness window index.
+func (kv *KVStore) GetMissedSignatures(
+	windowIndex uint64,
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	missed, err := kv.missedSignatures.Get(
+		kv.ctx, collections.Join(windowIndex, idx.Unwrap()),
+	)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return missed, nil
+}
+
+// GetMissedSignaturesInWindow retrieves the number of blocks the validator
+// did not sign over the whole liveness window.
+func (kv *KVStore) GetMissedSignaturesInWindow(idx math.ValidatorIndex) (uint64, error) {
+	missed, err := kv.missedSignaturesTotal.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return missed, nil
+}
+
+// IncrementMissedSignatures records a block the validator did not sign in the
+// given liveness window index.
+func (kv *KVStore) IncrementMissedSignatures(
+	windowIndex uint64,
+	idx math.ValidatorIndex,
+) error {
+	missed, err := kv.GetMissedSignatures(windowIndex, idx)
+	if err != nil {
+		return err
+	}
+	total, err := kv.GetMissedSignaturesInWindow(idx)
+	if err != nil {
+		return err
+	}
+	if err = kv.missedSignatures.Set(
+		kv.ctx, collections.Join(windowIndex, idx.Unwrap()), missed+1,
+	); err != nil {
+		return err
+	}
+	return kv.missedSignaturesTotal.Set(kv.ctx, idx.Unwrap(), total+1)
+}
+
+// ResetMissedSignatures clears the missed signatures of all validators in the
+// given liveness window index, removing them from the window totals.
+func (kv *KVStore) ResetMissedSignatures(windowIndex uint64) error {
+	ranger := collections.NewPrefixedPairRange[uint64, uint64](windowIndex)
+	iter, err := kv.missedSignatures.Iterate(kv.ctx, ranger)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range kvs {
+		idx := entry.Key.K2()
+		total, errInLoop := kv.GetMissedSignaturesInWindow(math.ValidatorIndex(idx))
+		if errInLoop != nil {
+			return errInLoop
+		}
+		if total <= entry.Value {
+			errInLoop = kv.missedSignaturesTotal.Remove(kv.ctx, idx)
+		} else {
+			errInLoop = kv.missedSignaturesTotal.Set(kv.ctx, idx, total-entry.Value)
+		}
+		if errInLoop != nil {
+			return errInLoop
+		}
+	}
+	return kv.missedSignatures.Clear(kv.ctx, ranger)
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMissedSignatures_IncrementGetAndReset verifies that missed signatures
+// default to zero, are counted per window index and in the window totals, and
+// are reset per window index only.
+func TestMissedSignatures_IncrementGetAndReset(t *testing.T) {
+	t.Parallel()
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	missed, err := store.GetMissedSignatures(0, math.ValidatorIndex(1))
+	require.NoError(t, err)
+	require.Zero(t, missed)
+
+	increment := func(windowIdx uint64, idx math.ValidatorIndex, times int) {
+		for range times {
+			require.NoError(t, store.IncrementMissedSignatures(windowIdx, idx))
+		}
+	}
+	increment(0, math.ValidatorIndex(1), 3)
+	increment(0, math.ValidatorIndex(2), 5)
+	increment(1, math.ValidatorIndex(1), 7)
+
+	missed, err = store.GetMissedSignatures(0, math.ValidatorIndex(2))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), missed)
+	missed, err = store.GetMissedSignaturesInWindow(math.ValidatorIndex(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), missed)
+
+	require.NoError(t, store.ResetMissedSignatures(0))
+	missed, err = store.GetMissedSignatures(0, math.ValidatorIndex(1))
+	require.NoError(t, err)
+	require.Zero(t, missed)
+	missed, err = store.GetMissedSignatures(0, math.ValidatorIndex(2))
+	require.NoError(t, err)
+	require.Zero(t, missed)
+	missed, err = store.GetMissedSignatures(1, math.ValidatorIndex(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), missed)
+	missed, err = store.GetMissedSignaturesInWindow(math.ValidatorIndex(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), missed)
+	missed, err = store.GetMissedSignaturesInWindow(math.ValidatorIndex(2))
+	require.NoError(t, err)
+	require.Zero(t, missed)
+}
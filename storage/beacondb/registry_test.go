@@ -292,9 +292,16 @@ func TestPendingPartialWithdrawals_Update(t *testing.T) {
 }
 
 func initTestStore() (*beacondb.KVStore, error) {
+	kv, _, err := initTestStoreWithContext()
+	return kv, err
+}
+
+// initTestStoreWithContext also returns the context of the store, which gives
+// access to the underlying raw store.
+func initTestStoreWithContext() (*beacondb.KVStore, sdk.Context, error) {
 	db, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
-		return nil, fmt.Errorf("failed opening mem db: %w", err)
+		return nil, sdk.Context{}, fmt.Errorf("failed opening mem db: %w", err)
 	}
 	var (
 		nopLog     = log.NewNopLogger()
@@ -309,12 +316,12 @@ func initTestStore() (*beacondb.KVStore, error) {
 
 	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
 	if err = cms.LoadLatestVersion(); err != nil {
-		return nil, fmt.Errorf("failed to load latest version: %w", err)
+		return nil, sdk.Context{}, fmt.Errorf("failed to load latest version: %w", err)
 	}
 
 	ctx := sdk.NewContext(cms, true, nopLog)
 	testStoreService := &testKVStoreService{
 		ctx: ctx,
 	}
-	return beacondb.New(testStoreService), nil
+	return beacondb.New(testStoreService), ctx, nil
 }
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb/keys"
+)
+
+// The functions below return the raw store keys of single beacon state
+// entries, so that their values can be read and proven directly against the
+// IAVL store, e.g. by ABCI queries.
+
+// ValidatorStoreKey returns the store key of the validator at the given index.
+// The value stored is the SSZ encoding of the validator.
+func ValidatorStoreKey(index math.ValidatorIndex) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.ValidatorByIndexPrefix}, sdkcollections.Uint64Key, index.Unwrap(),
+	)
+}
+
+// BalanceStoreKey returns the store key of the balance of the validator at the
+// given index. The value stored is the big-endian encoding of the balance.
+func BalanceStoreKey(index math.ValidatorIndex) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.BalancesPrefix}, sdkcollections.Uint64Key, index.Unwrap(),
+	)
+}
+
+// StateRootStoreKey returns the store key of the state root at the given index
+// of the state roots vector. The value stored is the 32 bytes root.
+func StateRootStoreKey(idx uint64) ([]byte, error) {
+	return sdkcollections.EncodeKeyWithPrefix(
+		[]byte{keys.StateRootsPrefix}, sdkcollections.Uint64Key, idx,
+	)
+}
+
+// Eth1DataStoreKey returns the store key of the latest eth1 data. The value
+// stored is the SSZ encoding of the eth1 data.
+func Eth1DataStoreKey() []byte {
+	return []byte{keys.Eth1DataPrefix}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreKeys(t *testing.T) {
+	t.Parallel()
+	kv, ctx, err := initTestStoreWithContext()
+	require.NoError(t, err)
+	raw := ctx.KVStore(testStoreKey)
+
+	// validator
+	val := &types.Validator{
+		Pubkey:           crypto.BLSPubkey{0x01},
+		EffectiveBalance: 32e9,
+	}
+	require.NoError(t, kv.AddValidator(val))
+	idx, err := kv.ValidatorIndexByPubkey(val.Pubkey)
+	require.NoError(t, err)
+	key, err := beacondb.ValidatorStoreKey(idx)
+	require.NoError(t, err)
+	valBz, err := val.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, valBz, raw.Get(key))
+
+	// balance
+	require.NoError(t, kv.SetBalance(idx, math.Gwei(31e9)))
+	key, err = beacondb.BalanceStoreKey(idx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(31e9), binary.BigEndian.Uint64(raw.Get(key)))
+
+	// state root
+	root := common.Root{0x02}
+	require.NoError(t, kv.UpdateStateRootAtIndex(3, root))
+	key, err = beacondb.StateRootStoreKey(3)
+	require.NoError(t, err)
+	require.Equal(t, root[:], raw.Get(key))
+
+	// eth1 data
+	eth1Data := types.NewEth1Data(common.Root{0x03})
+	require.NoError(t, kv.SetEth1Data(eth1Data))
+	eth1DataBz, err := eth1Data.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, eth1DataBz, raw.Get(beacondb.Eth1DataStoreKey()))
+}
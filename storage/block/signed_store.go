@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/encoding"
+)
+
+const (
+	KeySignedBlockPrefix    = "signed_block"
+	KeyBlockSignaturePrefix = "block_signature"
+)
+
+// ErrSignedBlockNotFound is returned when no signed block is stored for the
+// requested slot, either because it was never finalized or already pruned.
+var ErrSignedBlockNotFound = errors.New("signed block not found")
+
+// SignedStore is a disk backed store of the full signed beacon blocks
+// finalized by the node, indexed by slot. The proposer signature of every
+// block is kept in a separate index which is never pruned.
+type SignedStore struct {
+	blocks     sdkcollections.Map[uint64, *ctypes.SignedBeaconBlock]
+	signatures sdkcollections.Map[uint64, []byte]
+
+	// retention is the number of most recent slots to keep blocks for.
+	// A retention of 0 keeps every block.
+	retention uint64
+
+	// closeFunc is a closure that closes the underlying database.
+	// We guarantee that closeFunc is called at maximum only once.
+	closeFunc CloseFunc
+	once      sync.Once
+
+	// mu protects blocks for concurrent access.
+	mu sync.RWMutex
+
+	// logger is used for logging information and errors.
+	logger log.Logger
+}
+
+// NewSignedStore creates a new signed block store.
+func NewSignedStore(
+	kvsp store.KVStoreService,
+	closeFunc CloseFunc,
+	retention uint64,
+	logger log.Logger,
+) *SignedStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	res := &SignedStore{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySignedBlockPrefix)),
+			KeySignedBlockPrefix,
+			sdkcollections.Uint64Key,
+			encoding.SSZForkedValueCodec[*ctypes.SignedBeaconBlock]{
+				NewEmptyF: ctypes.NewEmptySignedBeaconBlockWithVersion,
+			},
+		),
+		signatures: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockSignaturePrefix)),
+			KeyBlockSignaturePrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		retention: retention,
+		closeFunc: closeFunc,
+		logger:    logger,
+	}
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building SignedStore schema"))
+	}
+	return res
+}
+
+// Close closes the store by calling the closeFunc. It ensures that the
+// closeFunc is called at most once.
+func (s *SignedStore) Close() error {
+	var err error
+	s.once.Do(func() { err = s.closeFunc() })
+	return err
+}
+
+// Set stores the signed block and its signature at its slot and prunes the
+// blocks that fell out of the retention window.
+func (s *SignedStore) Set(ctx context.Context, blk *ctypes.SignedBeaconBlock) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slot := blk.GetBeaconBlock().GetSlot().Unwrap()
+	if err := s.blocks.Set(ctx, slot, blk); err != nil {
+		return errors.Wrapf(err, "failed to store signed block at slot %d", slot)
+	}
+	signature := blk.GetSignature()
+	if err := s.signatures.Set(ctx, slot, signature[:]); err != nil {
+		return errors.Wrapf(err, "failed to store block signature at slot %d", slot)
+	}
+
+	if s.retention == 0 || slot < s.retention {
+		return nil
+	}
+	end := slot - s.retention + 1
+	rng := new(sdkcollections.Range[uint64]).EndExclusive(end)
+	if err := s.blocks.Clear(ctx, rng); err != nil {
+		return errors.Wrapf(err, "failed to prune signed blocks before slot %d", end)
+	}
+	return nil
+}
+
+// Get retrieves the signed block at the given slot. A slot of 0 resolves to
+// the latest stored block.
+func (s *SignedStore) Get(ctx context.Context, slot math.Slot) (*ctypes.SignedBeaconBlock, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if slot == 0 {
+		return s.latest(ctx)
+	}
+	blk, err := s.blocks.Get(ctx, slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return nil, errors.Wrapf(ErrSignedBlockNotFound, "slot %d", slot)
+	}
+	return blk, err
+}
+
+// GetSignature retrieves the proposer signature of the block at the given
+// slot. Signatures are retained for every stored block, even once the block
+// itself has been pruned.
+func (s *SignedStore) GetSignature(ctx context.Context, slot math.Slot) (crypto.BLSSignature, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	bz, err := s.signatures.Get(ctx, slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return crypto.BLSSignature{}, errors.Wrapf(ErrSignedBlockNotFound, "signature at slot %d", slot)
+	}
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return crypto.BLSSignature(bz), nil
+}
+
+// latest returns the block with the highest stored slot.
+func (s *SignedStore) latest(ctx context.Context) (*ctypes.SignedBeaconBlock, error) {
+	iter, err := s.blocks.Iterate(ctx, new(sdkcollections.Range[uint64]).Descending())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, errors.Wrap(ErrSignedBlockNotFound, "store is empty")
+	}
+	return iter.Value()
+}
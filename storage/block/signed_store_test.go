@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+
+package block_test
+
+import (
+	"context"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/testing/utils"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newSignedBlock(t *testing.T, slot math.Slot) *ctypes.SignedBeaconBlock {
+	t.Helper()
+	blk := utils.GenerateValidBeaconBlock(t, version.Deneb1())
+	blk.Slot = slot
+	return &ctypes.SignedBeaconBlock{BeaconBlock: blk}
+}
+
+func TestSignedStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := dbm.NewMemDB()
+	store := block.NewSignedStore(
+		storage.NewKVStoreProvider(db), db.Close, 3, noop.NewLogger[any](),
+	)
+
+	for i := math.Slot(1); i <= 5; i++ {
+		require.NoError(t, store.Set(ctx, newSignedBlock(t, i)))
+	}
+
+	// Only the last 3 blocks are retained.
+	for i := math.Slot(1); i <= 2; i++ {
+		_, err := store.Get(ctx, i)
+		require.ErrorIs(t, err, block.ErrSignedBlockNotFound)
+	}
+	for i := math.Slot(3); i <= 5; i++ {
+		blk, err := store.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, newSignedBlock(t, i).HashTreeRoot(), blk.HashTreeRoot())
+		require.Equal(t, version.Deneb1(), blk.GetForkVersion())
+	}
+
+	// Slot 0 resolves to the latest block.
+	blk, err := store.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), blk.GetSlot())
+	require.NoError(t, store.Close())
+}
+
+func TestSignedStoreRetainsEverything(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := dbm.NewMemDB()
+	store := block.NewSignedStore(
+		storage.NewKVStoreProvider(db), db.Close, 0, noop.NewLogger[any](),
+	)
+
+	_, err := store.Get(ctx, 0)
+	require.ErrorIs(t, err, block.ErrSignedBlockNotFound)
+
+	for i := math.Slot(1); i <= 10; i++ {
+		require.NoError(t, store.Set(ctx, newSignedBlock(t, i)))
+	}
+	for i := math.Slot(1); i <= 10; i++ {
+		_, err = store.Get(ctx, i)
+		require.NoError(t, err)
+	}
+}
+
+func TestSignedStoreRetainsSignatures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := dbm.NewMemDB()
+	store := block.NewSignedStore(
+		storage.NewKVStoreProvider(db), db.Close, 2, noop.NewLogger[any](),
+	)
+
+	_, err := store.GetSignature(ctx, 1)
+	require.ErrorIs(t, err, block.ErrSignedBlockNotFound)
+
+	for i := math.Slot(1); i <= 5; i++ {
+		blk := newSignedBlock(t, i)
+		blk.Signature = crypto.BLSSignature{byte(i), 0xff}
+		require.NoError(t, store.Set(ctx, blk))
+	}
+
+	// Pruned blocks still have their signature.
+	_, err = store.Get(ctx, 1)
+	require.ErrorIs(t, err, block.ErrSignedBlockNotFound)
+	for i := math.Slot(1); i <= 5; i++ {
+		sig, sErr := store.GetSignature(ctx, i)
+		require.NoError(t, sErr)
+		require.Equal(t, crypto.BLSSignature{byte(i), 0xff}, sig)
+	}
+}
@@ -21,28 +21,66 @@
 package block
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
+	"sync"
 
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	lru "github.com/hashicorp/golang-lru/v2"
 )
 
-// KVStore is a simple memory store based implementation that stores metadata of
+const (
+	KeyBlockRootsPrefix = "block_roots"
+	KeyTimestampsPrefix = "timestamps"
+	KeyStateRootsPrefix = "state_roots"
+	KeySlotsPrefix      = "slots"
+	KeyEarliestPrefix   = "earliest"
+
+	// slotEntrySize is the size of a slot entry: block root (32), state root
+	// (32) and timestamp (8).
+	slotEntrySize = 72
+)
+
+// CloseFunc is a closure type for closing a store.
+type CloseFunc func() error
+
+// KVStore is a simple KV store based implementation that stores metadata of
 // beacon blocks.
 type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	blockRoots sdkcollections.Map[[]byte, uint64]
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
 	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	timestamps sdkcollections.Map[uint64, uint64]
 
 	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	stateRoots sdkcollections.Map[[]byte, uint64]
+
+	// slots maps each indexed slot to its block root, state root and
+	// timestamp, so that the indices of a slot can be pruned.
+	slots sdkcollections.Map[uint64, []byte]
+
+	// earliest is the earliest slot from which on every slot is indexed.
+	earliest sdkcollections.Item[uint64]
+
+	// retention is the number of most recent slots to keep indexed, in step
+	// with the pruning of the app state. A retention of 0 keeps every slot.
+	retention uint64
+
+	// closeFunc is a closure that closes the underlying database.
+	// We guarantee that closeFunc is called at maximum only once.
+	closeFunc CloseFunc
+	once      sync.Once
+
+	// mu protects the indices for concurrent access.
+	mu sync.RWMutex
 
 	// Logger for the store.
 	logger log.Logger
@@ -50,49 +88,127 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 
 // NewStore creates a new block store.
 func NewStore[BeaconBlockT BeaconBlock](
+	kvsp store.KVStoreService,
+	closeFunc CloseFunc,
+	retention uint64,
 	logger log.Logger,
-	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
-	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
-	if err != nil {
-		panic(err)
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	res := &KVStore[BeaconBlockT]{
+		blockRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyBlockRootsPrefix)),
+			KeyBlockRootsPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		timestamps: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyTimestampsPrefix)),
+			KeyTimestampsPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		stateRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyStateRootsPrefix)),
+			KeyStateRootsPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		slots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySlotsPrefix)),
+			KeySlotsPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		earliest: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyEarliestPrefix)),
+			KeyEarliestPrefix,
+			sdkcollections.Uint64Value,
+		),
+		retention: retention,
+		closeFunc: closeFunc,
+		logger:    logger,
 	}
-	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building block KVStore schema"))
 	}
+	return res
+}
+
+// Close closes the store by calling the closeFunc. It ensures that the
+// closeFunc is called at most once.
+func (kv *KVStore[BeaconBlockT]) Close() error {
+	var err error
+	kv.once.Do(func() { err = kv.closeFunc() })
+	return err
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// timestamp, and state root. Only this function may potentially prune
+// entries from the store if the retention window is reached.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
 	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
-	return nil
+	err := kv.index(slot, blk.HashTreeRoot(), blk.GetTimestamp(), blk.GetStateRoot())
+	if err != nil {
+		return err
+	}
+
+	if kv.retention == 0 || slot.Unwrap() < kv.retention {
+		return nil
+	}
+	return kv.prune(slot.Unwrap() - kv.retention + 1)
+}
+
+// Index stores the indices of a slot which is older than the earliest indexed
+// slot, extending the range of indexed slots backwards. It is used to rebuild
+// the indices from the historical beacon states.
+func (kv *KVStore[BeaconBlockT]) Index(
+	slot math.Slot,
+	blockRoot common.Root,
+	timestamp math.U64,
+	stateRoot common.Root,
+) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.index(slot, blockRoot, timestamp, stateRoot)
+}
+
+// EarliestSlot returns the earliest slot from which on every slot is indexed.
+// It returns false if no slot has been indexed yet.
+func (kv *KVStore[BeaconBlockT]) EarliestSlot() (math.Slot, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	earliest, err := kv.earliest.Get(context.Background())
+	switch {
+	case err == nil:
+		return math.Slot(earliest), true, nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return 0, false, nil
+	default:
+		return 0, false, err
+	}
 }
 
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
-	if !ok {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	slot, err := kv.blockRoots.Get(context.Background(), blockRoot[:])
+	if err != nil {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
-	return slot, nil
+	return math.Slot(slot), nil
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
@@ -100,24 +216,143 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
-	slot, ok := kv.timestamps.Peek(timestamp)
-	if !ok {
-		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	slot, err := kv.timestamps.Get(context.Background(), timestamp.Unwrap())
+	if err != nil {
+		return 0, fmt.Errorf("slot not found at timestamp: %d", timestamp)
 	}
 	if slot == 0 {
-		return slot, errors.New("parent slot not supported for genesis slot 0")
+		return 0, errors.New("parent slot not supported for genesis slot 0")
 	}
 
-	return slot - 1, nil
+	return math.Slot(slot - 1), nil
 }
 
 // GetSlotByStateRoot retrieves the slot by a given state root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.stateRoots.Peek(stateRoot)
-	if !ok {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	slot, err := kv.stateRoots.Get(context.Background(), stateRoot[:])
+	if err != nil {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
-	return slot, nil
+	return math.Slot(slot), nil
+}
+
+// index stores the indices of the slot and lowers the earliest indexed slot
+// if needed. The caller must hold the write lock.
+func (kv *KVStore[BeaconBlockT]) index(
+	slot math.Slot,
+	blockRoot common.Root,
+	timestamp math.U64,
+	stateRoot common.Root,
+) error {
+	ctx := context.Background()
+
+	// Drop the indices of a block previously stored at the same slot, which
+	// may happen if the node state was rolled back.
+	prev, err := kv.slots.Get(ctx, slot.Unwrap())
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		if err = kv.removeEntry(ctx, slot.Unwrap(), prev); err != nil {
+			return err
+		}
+	}
+
+	entry := make([]byte, 0, slotEntrySize)
+	entry = append(entry, blockRoot[:]...)
+	entry = append(entry, stateRoot[:]...)
+	entry = binary.BigEndian.AppendUint64(entry, timestamp.Unwrap())
+
+	if err = kv.slots.Set(ctx, slot.Unwrap(), entry); err != nil {
+		return errors.Wrapf(err, "failed to index slot %d", slot)
+	}
+	if err = kv.blockRoots.Set(ctx, blockRoot[:], slot.Unwrap()); err != nil {
+		return errors.Wrapf(err, "failed to index block root of slot %d", slot)
+	}
+	if err = kv.timestamps.Set(ctx, timestamp.Unwrap(), slot.Unwrap()); err != nil {
+		return errors.Wrapf(err, "failed to index timestamp of slot %d", slot)
+	}
+	if err = kv.stateRoots.Set(ctx, stateRoot[:], slot.Unwrap()); err != nil {
+		return errors.Wrapf(err, "failed to index state root of slot %d", slot)
+	}
+
+	earliest, err := kv.earliest.Get(ctx)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return kv.earliest.Set(ctx, slot.Unwrap())
+	case err != nil:
+		return err
+	case slot.Unwrap() < earliest:
+		return kv.earliest.Set(ctx, slot.Unwrap())
+	default:
+		return nil
+	}
+}
+
+// prune removes the indices of all slots before end. The caller must hold the
+// write lock.
+func (kv *KVStore[BeaconBlockT]) prune(end uint64) error {
+	ctx := context.Background()
+	iter, err := kv.slots.Iterate(ctx, new(sdkcollections.Range[uint64]).EndExclusive(end))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var pruned []uint64
+	for ; iter.Valid(); iter.Next() {
+		kvPair, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		if err = kv.removeEntry(ctx, kvPair.Key, kvPair.Value); err != nil {
+			return err
+		}
+		pruned = append(pruned, kvPair.Key)
+	}
+	for _, slot := range pruned {
+		if err = kv.slots.Remove(ctx, slot); err != nil {
+			return err
+		}
+	}
+
+	if len(pruned) > 0 {
+		kv.logger.Debug("Pruned block indices", "start", pruned[0], "end", end)
+	}
+
+	earliest, err := kv.earliest.Get(ctx)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case earliest < end:
+		return kv.earliest.Set(ctx, end)
+	default:
+		return nil
+	}
+}
+
+// removeEntry removes the block root, state root and timestamp indices of a
+// slot entry. The caller must hold the write lock.
+func (kv *KVStore[BeaconBlockT]) removeEntry(ctx context.Context, slot uint64, entry []byte) error {
+	if len(entry) != slotEntrySize {
+		return fmt.Errorf("invalid index entry of slot %d", slot)
+	}
+	if err := kv.blockRoots.Remove(ctx, entry[:32]); err != nil {
+		return err
+	}
+	if err := kv.stateRoots.Remove(ctx, entry[32:64]); err != nil {
+		return err
+	}
+	return kv.timestamps.Remove(ctx, binary.BigEndian.Uint64(entry[64:]))
 }
@@ -24,9 +24,11 @@ import (
 	"testing"
 
 	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/block"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,7 +54,10 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 
 func TestBlockStore(t *testing.T) {
 	t.Parallel()
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	db := dbm.NewMemDB()
+	blockStore := block.NewStore[*MockBeaconBlock](
+		storage.NewKVStoreProvider(db), db.Close, 5, noop.NewLogger[any](),
+	)
 
 	var (
 		slot math.Slot
@@ -86,4 +91,50 @@ func TestBlockStore(t *testing.T) {
 	require.ErrorContains(t, err, "not found")
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
+
+	// Slots 1 and 2 have been pruned.
+	_, err = blockStore.GetSlotByBlockRoot([32]byte{byte(1)})
+	require.ErrorContains(t, err, "not found")
+	earliest, ok, err := blockStore.EarliestSlot()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, math.Slot(3), earliest)
+}
+
+func TestBlockStoreIndex(t *testing.T) {
+	t.Parallel()
+	db := dbm.NewMemDB()
+	blockStore := block.NewStore[*MockBeaconBlock](
+		storage.NewKVStoreProvider(db), db.Close, 0, noop.NewLogger[any](),
+	)
+
+	_, ok, err := blockStore.EarliestSlot()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: 10}))
+
+	// Index older slots, extending the indexed range backwards.
+	for i := math.Slot(9); i >= 5; i-- {
+		err = blockStore.Index(i, common.Root{byte(i)}, i, common.Root{byte(i)})
+		require.NoError(t, err)
+	}
+	earliest, ok, err := blockStore.EarliestSlot()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, math.Slot(5), earliest)
+
+	slot, err := blockStore.GetSlotByStateRoot(common.Root{byte(7)})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), slot)
+
+	// Overwriting a slot drops the indices of the previous block.
+	err = blockStore.Index(7, common.Root{0xff}, 100, common.Root{0xff})
+	require.NoError(t, err)
+	_, err = blockStore.GetSlotByBlockRoot(common.Root{byte(7)})
+	require.ErrorContains(t, err, "not found")
+	slot, err = blockStore.GetSlotByBlockRoot(common.Root{0xff})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), slot)
+	require.NoError(t, blockStore.Close())
 }
@@ -163,3 +163,18 @@ func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 	kv.logger.Debug("Pruned deposits", "start", start, "end", end)
 	return nil
 }
+
+// Truncate removes the deposits from the given index onwards, to bring the
+// store back in sync with a beacon state that was rolled back.
+func (kv *KVStore) Truncate(ctx context.Context, from uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if err := kv.store.Clear(
+		ctx, new(sdkcollections.Range[uint64]).StartInclusive(from),
+	); err != nil {
+		return errors.Wrapf(err, "failed to truncate deposits from %d", from)
+	}
+
+	kv.logger.Debug("Truncated deposits", "from", from)
+	return nil
+}
@@ -104,3 +104,60 @@ func (cdc *SSZVersionedValueCodec[T]) Stringify(value T) string {
 func (cdc *SSZVersionedValueCodec[T]) ValueType() string {
 	return "SSZVersionedMarshallable"
 }
+
+// SSZForkedValueCodec provides methods to encode and decode SSZ values whose
+// layout depends on the fork version they were created at. The fork version
+// is stored as a prefix of the encoded value, so that values of different
+// forks can live side by side in the same store.
+type SSZForkedValueCodec[T constraints.SSZVersionedMarshallable] struct {
+	NewEmptyF func(common.Version) (T, error) // constructor
+}
+
+// Encode marshals the provided value into its fork version followed by its
+// SSZ encoding.
+func (SSZForkedValueCodec[T]) Encode(value T) ([]byte, error) {
+	bz, err := value.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	forkVersion := value.GetForkVersion()
+	return append(forkVersion[:], bz...), nil
+}
+
+// Decode unmarshals the provided bytes into a value of type T, using the fork
+// version prefix to pick the layout.
+func (sc SSZForkedValueCodec[T]) Decode(bz []byte) (T, error) {
+	var (
+		dest        T
+		forkVersion common.Version
+	)
+	if len(bz) < len(forkVersion) {
+		return dest, ErrInvalidForkedValue
+	}
+	copy(forkVersion[:], bz)
+	dest, err := sc.NewEmptyF(forkVersion)
+	if err != nil {
+		return dest, err
+	}
+	return dest, ssz.Unmarshal(bz[len(forkVersion):], dest)
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (SSZForkedValueCodec[T]) EncodeJSON(_ T) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (SSZForkedValueCodec[T]) DecodeJSON(_ []byte) (T, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the provided value.
+func (SSZForkedValueCodec[T]) Stringify(value T) string {
+	return spew.Sdump(value)
+}
+
+// ValueType returns the name of the interface that this codec is intended for.
+func (SSZForkedValueCodec[T]) ValueType() string {
+	return "SSZForkedMarshallable"
+}
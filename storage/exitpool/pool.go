@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package exitpool
+
+import (
+	"slices"
+	"sync"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrNilVoluntaryExit is returned when adding a nil voluntary exit to the pool.
+var ErrNilVoluntaryExit = errors.New("nil voluntary exit")
+
+// Pool is an in-memory pool of the signed voluntary exits submitted to this
+// node, waiting to be included in a block proposed by this node. The pool
+// holds at most one exit per validator, in submission order.
+//
+// Exits are not validated when added: the block builder verifies them against
+// the state it builds on and removes the ones that no longer apply, such as
+// exits of validators already exiting.
+type Pool struct {
+	// exits are the pending exits, in submission order.
+	exits []*ctypes.SignedVoluntaryExit
+
+	// mu protects exits for concurrent access.
+	mu sync.RWMutex
+}
+
+// New creates a new empty voluntary exit pool.
+func New() *Pool {
+	return &Pool{}
+}
+
+// Add inserts the exit in the pool. An exit for a validator already in the
+// pool replaces the previous one.
+func (p *Pool) Add(exit *ctypes.SignedVoluntaryExit) error {
+	if exit == nil || exit.Message == nil {
+		return ErrNilVoluntaryExit
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idx := slices.IndexFunc(p.exits, func(e *ctypes.SignedVoluntaryExit) bool {
+		return e.Message.ValidatorIndex == exit.Message.ValidatorIndex
+	})
+	if idx >= 0 {
+		p.exits[idx] = exit
+		return nil
+	}
+	p.exits = append(p.exits, exit)
+	return nil
+}
+
+// Pending returns the exits in the pool, in submission order.
+func (p *Pool) Pending() []*ctypes.SignedVoluntaryExit {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return slices.Clone(p.exits)
+}
+
+// Remove drops the exit of the given validator from the pool, if any.
+func (p *Pool) Remove(validatorIndex math.ValidatorIndex) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.exits = slices.DeleteFunc(p.exits, func(e *ctypes.SignedVoluntaryExit) bool {
+		return e.Message.ValidatorIndex == validatorIndex
+	})
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package exitpool_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/storage/exitpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+	pool := exitpool.New()
+	require.ErrorIs(t, pool.Add(nil), exitpool.ErrNilVoluntaryExit)
+	require.ErrorIs(t, pool.Add(&ctypes.SignedVoluntaryExit{}), exitpool.ErrNilVoluntaryExit)
+
+	exit1 := &ctypes.SignedVoluntaryExit{Message: &ctypes.VoluntaryExit{ValidatorIndex: 1}}
+	exit2 := &ctypes.SignedVoluntaryExit{Message: &ctypes.VoluntaryExit{ValidatorIndex: 2}}
+	require.NoError(t, pool.Add(exit1))
+	require.NoError(t, pool.Add(exit2))
+	require.Equal(t, []*ctypes.SignedVoluntaryExit{exit1, exit2}, pool.Pending())
+
+	// A new exit for the same validator replaces the previous one in place.
+	exit1Bis := &ctypes.SignedVoluntaryExit{Message: &ctypes.VoluntaryExit{Epoch: 3, ValidatorIndex: 1}}
+	require.NoError(t, pool.Add(exit1Bis))
+	require.Equal(t, []*ctypes.SignedVoluntaryExit{exit1Bis, exit2}, pool.Pending())
+
+	pool.Remove(1)
+	require.Equal(t, []*ctypes.SignedVoluntaryExit{exit2}, pool.Pending())
+	pool.Remove(1)
+	require.Equal(t, []*ctypes.SignedVoluntaryExit{exit2}, pool.Pending())
+}
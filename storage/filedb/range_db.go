@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -153,6 +154,36 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return err
 }
 
+// Truncate removes all values with an index greater than or equal to from.
+// Unlike Prune it does not move the lower bound index, as it is meant to
+// discard data ahead of a rolled back chain rather than data behind it.
+func (db *RangeDB) Truncate(from uint64) error {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	entries, err := afero.ReadDir(db.coreDB.fs, ".")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		index, parseErr := strconv.ParseUint(entry.Name(), 10, 64)
+		if parseErr != nil || index < from {
+			continue
+		}
+		if err = db.coreDB.fs.RemoveAll(fmt.Sprintf(pathFormat, index)); err != nil {
+			return fmt.Errorf(
+				"RangeDB Truncate failed RemoveAll index %d: %w", index, err,
+			)
+		}
+	}
+	return nil
+}
+
 // GetByIndex takes the database index and returns all associated entries,
 // expecting database keys to follow the prefix() format. If index does not
 // exist in the DB for any reason (pruned, invalid index), an empty list is
@@ -273,6 +273,24 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_Truncate(t *testing.T) {
+	t.Parallel()
+	rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-truncate"))
+	require.NoError(t, populateTestDB(rdb, 0, 50))
+
+	require.NoError(t, rdb.Truncate(20))
+	requireExist(t, rdb, 0, 19)
+	requireNotExist(t, rdb, 20, 50)
+
+	// Truncating past the stored indexes is a no-op.
+	require.NoError(t, rdb.Truncate(30))
+	requireExist(t, rdb, 0, 19)
+
+	// Truncation does not prevent storing the truncated indexes again.
+	require.NoError(t, populateTestDB(rdb, 20, 25))
+	requireExist(t, rdb, 0, 25)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/mem"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -43,6 +44,20 @@ func (k KVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
 	return NewKVStore(sdk.UnwrapSDKContext(ctx).KVStore(k.Key))
 }
 
+// MemKVStoreService is a KVStoreService backed by a single in-memory store,
+// whatever the context it is opened with.
+type MemKVStoreService struct {
+	store storetypes.KVStore
+}
+
+func NewMemKVStoreService() *MemKVStoreService {
+	return &MemKVStoreService{store: mem.NewStore()}
+}
+
+func (k *MemKVStoreService) OpenKVStore(context.Context) store.KVStore {
+	return NewKVStore(k.store)
+}
+
 // CoreKVStore is a wrapper of Core/Store kvstore interface
 // Remove after https://github.com/cosmos/cosmos-sdk/issues/14714 is closed.
 type coreKVStore struct {
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrEmptyPayload is returned when an extension payload of a snapshot is
+	// empty.
+	ErrEmptyPayload = errors.New("empty snapshot extension payload")
+
+	// ErrUnknownPayloadKind is returned when an extension payload of a
+	// snapshot has an unknown kind.
+	ErrUnknownPayloadKind = errors.New("unknown snapshot extension payload kind")
+
+	// ErrMissingDeposits is returned when the deposit store lacks deposits
+	// included in the chain up to the snapshot height.
+	ErrMissingDeposits = errors.New("deposit store is missing deposits of the snapshot height")
+)
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+const (
+	// ExtensionName is the name of the beacon-kit extension of the state-sync
+	// snapshots, which follows the IAVL multistore in the snapshot stream.
+	ExtensionName = "beacon-kit"
+
+	// ExtensionFormat is the format of the extension payloads. Each payload
+	// is a payload kind byte followed by the SSZ encoding of the item.
+	ExtensionFormat uint32 = 1
+
+	// depositsPageSize is the number of deposits read at once from the
+	// deposit store when taking a snapshot.
+	depositsPageSize uint64 = 1024
+)
+
+// Kinds of the extension payloads.
+const (
+	depositPayload byte = iota
+	blobSidecarPayload
+)
+
+var _ snapshottypes.ExtensionSnapshotter = (*Extension)(nil)
+
+// Extension snapshots the beacon-kit stores that live outside of the IAVL
+// multistore: the deposits included in the chain up to the snapshot height and
+// the blob sidecars within the data availability window of that height. Both
+// stores share a single extension so that they are restored together.
+type Extension struct {
+	depositStore      DepositStore
+	availabilityStore AvailabilityStore
+	stateReader       StateReader
+	cs                ChainSpec
+	logger            log.Logger
+}
+
+// NewExtension creates a new snapshot extension over the given stores. The
+// state reader bounds the deposits of a snapshot to those of its height.
+func NewExtension(
+	depositStore DepositStore,
+	availabilityStore AvailabilityStore,
+	stateReader StateReader,
+	cs ChainSpec,
+	logger log.Logger,
+) *Extension {
+	return &Extension{
+		depositStore:      depositStore,
+		availabilityStore: availabilityStore,
+		stateReader:       stateReader,
+		cs:                cs,
+		logger:            logger,
+	}
+}
+
+// SnapshotName returns the name of the extension.
+func (*Extension) SnapshotName() string {
+	return ExtensionName
+}
+
+// SnapshotFormat returns the format used to write the extension payloads.
+func (*Extension) SnapshotFormat() uint32 {
+	return ExtensionFormat
+}
+
+// SupportedFormats returns the formats the extension can restore from.
+func (*Extension) SupportedFormats() []uint32 {
+	return []uint32{ExtensionFormat}
+}
+
+// SnapshotExtension writes the deposits included in the chain up to the
+// snapshot height, followed by the blob sidecars of the slots within the data
+// availability window of that height.
+func (e *Extension) SnapshotExtension(
+	height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	var numDeposits, numSidecars int
+
+	// Snapshots are taken asynchronously, so the deposit store may already
+	// hold deposits of later blocks. Those are left out by stopping at the
+	// eth1 deposit index of the state at the snapshot height.
+	depositIndex, err := e.stateReader.Eth1DepositIndexAt(height)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get eth1 deposit index at height %d", height)
+	}
+
+	// The deposit store is never pruned, as depositPruneRangeFn of the
+	// blockchain service always returns an empty range: every node keeps the
+	// full deposit list, whose root is part of the beacon state. Deposits are
+	// hence read in pages from index 0.
+	for start := uint64(0); start < depositIndex; start += depositsPageSize {
+		count := min(depositsPageSize, depositIndex-start)
+		deposits, gErr := e.depositStore.GetDepositsByIndex(context.Background(), start, count)
+		if gErr != nil {
+			return errors.Wrapf(gErr, "failed to get deposits from index %d", start)
+		}
+		for _, deposit := range deposits {
+			if err = writePayload(payloadWriter, depositPayload, deposit); err != nil {
+				return err
+			}
+		}
+		numDeposits += len(deposits)
+		if uint64(len(deposits)) < count {
+			return errors.Wrapf(
+				ErrMissingDeposits, "found %d deposits, expected %d", numDeposits, depositIndex,
+			)
+		}
+	}
+
+	// CometBFT heights are beacon slots.
+	for slot := e.blobWindowStart(height); slot <= height; slot++ {
+		sidecars, err := e.availabilityStore.GetBlobSidecars(math.Slot(slot))
+		if err != nil {
+			return errors.Wrapf(err, "failed to get blob sidecars at slot %d", slot)
+		}
+		for _, sidecar := range sidecars {
+			if err = writePayload(payloadWriter, blobSidecarPayload, sidecar); err != nil {
+				return err
+			}
+		}
+		numSidecars += len(sidecars)
+	}
+
+	e.logger.Info(
+		"Snapshotted beacon-kit stores",
+		"height", height, "deposits", numDeposits, "blob_sidecars", numSidecars,
+	)
+	return nil
+}
+
+// RestoreExtension restores the deposits and blob sidecars of the snapshot.
+// Every payload is decoded before anything is written, so that a corrupted
+// snapshot leaves the stores untouched. State sync restores into empty stores,
+// so if writing fails midway the deposits and blob sidecars written so far are
+// truncated again, and the restore can be retried from a clean slate.
+func (e *Extension) RestoreExtension(
+	height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != ExtensionFormat {
+		return errors.Wrapf(snapshottypes.ErrUnknownFormat, "format %d", format)
+	}
+
+	var (
+		deposits []*ctypes.Deposit
+		sidecars = make(map[math.Slot]datypes.BlobSidecars)
+	)
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(payload) == 0 {
+			return ErrEmptyPayload
+		}
+
+		switch payload[0] {
+		case depositPayload:
+			deposit := ctypes.NewEmptyDeposit()
+			if err = ssz.Unmarshal(payload[1:], deposit); err != nil {
+				return errors.Wrap(err, "failed to decode deposit")
+			}
+			deposits = append(deposits, deposit)
+		case blobSidecarPayload:
+			sidecar := new(datypes.BlobSidecar)
+			if err = ssz.Unmarshal(payload[1:], sidecar); err != nil {
+				return errors.Wrap(err, "failed to decode blob sidecar")
+			}
+			slot := sidecar.GetBeaconBlockHeader().GetSlot()
+			sidecars[slot] = append(sidecars[slot], sidecar)
+		default:
+			return errors.Wrapf(ErrUnknownPayloadKind, "kind %d", payload[0])
+		}
+	}
+
+	slots := make([]math.Slot, 0, len(sidecars))
+	for slot := range sidecars {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+	if err := e.writeRestored(deposits, slots, sidecars); err != nil {
+		return errors.Join(err, e.truncateRestored(deposits, slots))
+	}
+
+	e.logger.Info(
+		"Restored beacon-kit stores from snapshot",
+		"height", height, "deposits", len(deposits), "blob_sidecar_slots", len(slots),
+	)
+	return nil
+}
+
+// writeRestored writes the restored deposits, then the restored blob sidecars
+// in slot order.
+func (e *Extension) writeRestored(
+	deposits []*ctypes.Deposit,
+	slots []math.Slot,
+	sidecars map[math.Slot]datypes.BlobSidecars,
+) error {
+	if err := e.depositStore.EnqueueDeposits(context.Background(), deposits); err != nil {
+		return errors.Wrap(err, "failed to restore deposits")
+	}
+	for _, slot := range slots {
+		if err := e.availabilityStore.Persist(sidecars[slot]); err != nil {
+			return errors.Wrapf(err, "failed to restore blob sidecars at slot %d", slot)
+		}
+	}
+	return nil
+}
+
+// truncateRestored removes whatever writeRestored may have written, from the
+// first restored deposit and the first restored slot onwards.
+func (e *Extension) truncateRestored(deposits []*ctypes.Deposit, slots []math.Slot) error {
+	var errs []error
+	if len(deposits) > 0 {
+		from := deposits[0].GetIndex().Unwrap()
+		if err := e.depositStore.Truncate(context.Background(), from); err != nil {
+			errs = append(errs, errors.Wrap(err, "failed to truncate restored deposits"))
+		}
+	}
+	if len(slots) > 0 {
+		if err := e.availabilityStore.Truncate(slots[0].Unwrap()); err != nil {
+			errs = append(errs, errors.Wrap(err, "failed to truncate restored blob sidecars"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// blobWindowStart returns the first slot whose blob sidecars are kept by the
+// availability store at the given slot, mirroring its pruning.
+func (e *Extension) blobWindowStart(slot uint64) uint64 {
+	window := e.cs.MinEpochsForBlobsSidecarsRequest().Unwrap() * e.cs.SlotsPerEpoch()
+	if slot < window {
+		return 0
+	}
+	return slot - window
+}
+
+// writePayload writes the SSZ encoding of the item, prefixed by its kind.
+func writePayload(
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+	kind byte,
+	item interface{ MarshalSSZ() ([]byte, error) },
+) error {
+	bz, err := item.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return payloadWriter(append([]byte{kind}, bz...))
+}
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/snapshot"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// chainSpec keeps the blob sidecars of the last 2 slots.
+type chainSpec struct{}
+
+func (chainSpec) SlotsPerEpoch() uint64 { return 1 }
+
+func (chainSpec) MinEpochsForBlobsSidecarsRequest() math.Epoch { return 2 }
+
+// stateReader reports the same eth1 deposit index at every height.
+type stateReader uint64
+
+func (r stateReader) Eth1DepositIndexAt(uint64) (uint64, error) { return uint64(r), nil }
+
+// failingAvailabilityStore fails to persist the blob sidecars of a slot.
+type failingAvailabilityStore struct {
+	*store.Store
+	failAt math.Slot
+}
+
+func (s failingAvailabilityStore) Persist(sidecars datypes.BlobSidecars) error {
+	if sidecars[0].GetBeaconBlockHeader().GetSlot() == s.failAt {
+		return errPersist
+	}
+	return s.Store.Persist(sidecars)
+}
+
+var errPersist = errors.New("persist failed")
+
+func newStores(t *testing.T) (*depositstore.KVStore, *store.Store) {
+	t.Helper()
+	logger := log.NewNopLogger()
+	depositStore := depositstore.NewStore(
+		storage.NewKVStoreProvider(dbm.NewMemDB()),
+		func() error { return nil },
+		logger,
+	)
+	availabilityStore := store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger,
+	)
+	return depositStore, availabilityStore
+}
+
+func newSidecars(slot math.Slot, count int) datypes.BlobSidecars {
+	sidecars := make(datypes.BlobSidecars, count)
+	for i := range sidecars {
+		sidecars[i] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{byte(i)},
+			SignedBeaconBlockHeader: &ctypes.SignedBeaconBlockHeader{
+				Header: &ctypes.BeaconBlockHeader{Slot: slot},
+			},
+			InclusionProof: make([]common.Root, ctypes.KZGInclusionProofDepth),
+		}
+	}
+	return sidecars
+}
+
+func payloadReader(payloads [][]byte) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
+
+func TestExtension_RoundTrip(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const height = 5
+
+	depositStore, availabilityStore := newStores(t)
+	deposits := make([]*ctypes.Deposit, 3)
+	for i := range deposits {
+		deposits[i] = &ctypes.Deposit{Amount: math.Gwei(i + 1), Index: uint64(i)}
+	}
+	require.NoError(t, depositStore.EnqueueDeposits(ctx, deposits))
+	// A deposit of a block after the snapshot height is left out.
+	require.NoError(t, depositStore.EnqueueDeposits(
+		ctx, []*ctypes.Deposit{{Amount: 4, Index: 3}},
+	))
+	for slot := math.Slot(1); slot <= height; slot++ {
+		require.NoError(t, availabilityStore.Persist(newSidecars(slot, 2)))
+	}
+
+	var payloads [][]byte
+	ext := snapshot.NewExtension(
+		depositStore, availabilityStore, stateReader(len(deposits)), chainSpec{}, log.NewNopLogger(),
+	)
+	require.NoError(t, ext.SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+
+	restoredDeposits, restoredAvailability := newStores(t)
+	restored := snapshot.NewExtension(
+		restoredDeposits, restoredAvailability, stateReader(0), chainSpec{}, log.NewNopLogger(),
+	)
+	require.NoError(t, restored.RestoreExtension(
+		height, snapshot.ExtensionFormat, payloadReader(payloads),
+	))
+
+	gotDeposits, err := restoredDeposits.GetDepositsByIndex(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, ctypes.Deposits(deposits), gotDeposits)
+
+	// Only the sidecars within the window of the snapshot height are restored.
+	for slot := math.Slot(1); slot <= height; slot++ {
+		sidecars, sErr := restoredAvailability.GetBlobSidecars(slot)
+		require.NoError(t, sErr)
+		if slot < height-2 {
+			require.Empty(t, sidecars)
+			continue
+		}
+		require.Len(t, sidecars, 2)
+	}
+}
+
+func TestExtension_RestoreCorruptedLeavesStoresUntouched(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	depositStore, availabilityStore := newStores(t)
+	require.NoError(t, depositStore.EnqueueDeposits(
+		ctx, []*ctypes.Deposit{{Amount: 1, Index: 0}},
+	))
+
+	var payloads [][]byte
+	ext := snapshot.NewExtension(
+		depositStore, availabilityStore, stateReader(1), chainSpec{}, log.NewNopLogger(),
+	)
+	require.NoError(t, ext.SnapshotExtension(1, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	payloads = append(payloads, []byte{0xff})
+
+	restoredDeposits, restoredAvailability := newStores(t)
+	restored := snapshot.NewExtension(
+		restoredDeposits, restoredAvailability, stateReader(0), chainSpec{}, log.NewNopLogger(),
+	)
+	err := restored.RestoreExtension(1, snapshot.ExtensionFormat, payloadReader(payloads))
+	require.ErrorIs(t, err, snapshot.ErrUnknownPayloadKind)
+
+	gotDeposits, err := restoredDeposits.GetDepositsByIndex(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, gotDeposits)
+}
+
+func TestExtension_SnapshotMissingDeposits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	depositStore, availabilityStore := newStores(t)
+	require.NoError(t, depositStore.EnqueueDeposits(
+		ctx, []*ctypes.Deposit{{Amount: 1, Index: 0}},
+	))
+
+	ext := snapshot.NewExtension(
+		depositStore, availabilityStore, stateReader(2), chainSpec{}, log.NewNopLogger(),
+	)
+	err := ext.SnapshotExtension(1, func([]byte) error { return nil })
+	require.ErrorIs(t, err, snapshot.ErrMissingDeposits)
+}
+
+func TestExtension_RestoreFailureTruncatesWrites(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const height = 3
+
+	depositStore, availabilityStore := newStores(t)
+	deposits := []*ctypes.Deposit{{Amount: 1, Index: 0}, {Amount: 2, Index: 1}}
+	require.NoError(t, depositStore.EnqueueDeposits(ctx, deposits))
+	for slot := math.Slot(1); slot <= height; slot++ {
+		require.NoError(t, availabilityStore.Persist(newSidecars(slot, 1)))
+	}
+
+	var payloads [][]byte
+	ext := snapshot.NewExtension(
+		depositStore, availabilityStore, stateReader(len(deposits)), chainSpec{}, log.NewNopLogger(),
+	)
+	require.NoError(t, ext.SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+
+	// The sidecars of the last slot fail to persist, after the deposits and
+	// the sidecars of the previous slots were written.
+	restoredDeposits, restoredAvailability := newStores(t)
+	restored := snapshot.NewExtension(
+		restoredDeposits,
+		failingAvailabilityStore{Store: restoredAvailability, failAt: height},
+		stateReader(0),
+		chainSpec{},
+		log.NewNopLogger(),
+	)
+	err := restored.RestoreExtension(height, snapshot.ExtensionFormat, payloadReader(payloads))
+	require.ErrorIs(t, err, errPersist)
+
+	gotDeposits, err := restoredDeposits.GetDepositsByIndex(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, gotDeposits)
+	for slot := math.Slot(1); slot <= height; slot++ {
+		sidecars, sErr := restoredAvailability.GetBlobSidecars(slot)
+		require.NoError(t, sErr)
+		require.Empty(t, sidecars)
+	}
+}
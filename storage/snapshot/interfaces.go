@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapshot
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// DepositStore is the store of the deposits processed by the chain.
+type DepositStore interface {
+	// GetDepositsByIndex returns up to depRange deposits from startIndex.
+	GetDepositsByIndex(
+		ctx context.Context, startIndex uint64, depRange uint64,
+	) (ctypes.Deposits, error)
+	// EnqueueDeposits stores the given deposits at their index.
+	EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error
+	// Truncate removes the deposits from the given index onwards.
+	Truncate(ctx context.Context, from uint64) error
+}
+
+// AvailabilityStore is the store of the blob sidecars of the chain.
+type AvailabilityStore interface {
+	// GetBlobSidecars returns the blob sidecars of the given slot.
+	GetBlobSidecars(slot math.Slot) (datypes.BlobSidecars, error)
+	// Persist stores the given blob sidecars of a single slot.
+	Persist(sidecars datypes.BlobSidecars) error
+	// Truncate removes the blob sidecars from the given slot onwards.
+	Truncate(from uint64) error
+}
+
+// StateReader reads the beacon states committed by the chain.
+type StateReader interface {
+	// Eth1DepositIndexAt returns the eth1 deposit index of the beacon state
+	// committed at the given height, i.e. the number of deposits included
+	// in the chain up to that height.
+	Eth1DepositIndexAt(height uint64) (uint64, error)
+}
+
+// ChainSpec defines the chain spec values needed to bound the blob window.
+type ChainSpec interface {
+	SlotsPerEpoch() uint64
+	MinEpochsForBlobsSidecarsRequest() math.Epoch
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -59,11 +60,12 @@ func (s *BeaconKitE2ESuite) TestEVMInflation() {
 		payloadTime := payload.Time()
 		inflationPerBlock = chainspec.EVMInflationPerBlock(math.U64(payloadTime))
 		inflationAddress = chainspec.EVMInflationAddress(math.U64(payloadTime))
-		if chainspec.Deneb1ForkTime() > 0 && payloadTime >= chainspec.Deneb1ForkTime() {
+		deneb1ForkTime := chainspec.ForkTime(version.Deneb1())
+		if deneb1ForkTime > 0 && payloadTime >= deneb1ForkTime {
 			// If we have passed the Deneb1 fork, do some verifications and update inflation values.
 			onceOnFork.Do(func() {
-				oldInflationPerBlock := chainspec.EVMInflationPerBlock(math.U64(chainspec.Deneb1ForkTime() - 1))
-				oldInflationAddress = chainspec.EVMInflationAddress(math.U64(chainspec.Deneb1ForkTime() - 1))
+				oldInflationPerBlock := chainspec.EVMInflationPerBlock(math.U64(deneb1ForkTime - 1))
+				oldInflationAddress = chainspec.EVMInflationAddress(math.U64(deneb1ForkTime - 1))
 
 				// Verify the post fork inflation changes
 				s.Require().NotEqual(oldInflationPerBlock, inflationPerBlock)
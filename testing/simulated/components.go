@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func FixedComponents(t *testing.T) []any {
@@ -37,6 +38,7 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideAvailabilityStore,
 		components.ProvideDepositContract,
 		components.ProvideBlockStore,
+		components.ProvideSignedBlockStore,
 		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor,
 		components.ProvideBlobProofVerifier,
@@ -46,6 +48,7 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
 		components.ProvideEngineClient,
+		components.ProvideEventBroker,
 		components.ProvideExecutionEngine,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
@@ -74,6 +77,7 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 	return c
 }
@@ -83,8 +87,11 @@ func ProvideElectraGenesisChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	// Both Deneb1 and Electra happen in genesis.
 	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 0
+	specData.Forks = []chain.ForkData{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+		{Name: "deneb1", Version: version.Deneb1(), Timestamp: 0},
+		{Name: "electra", Version: version.Electra(), Timestamp: 0},
+	}
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -97,8 +104,8 @@ func ProvideElectraGenesisChainSpec() (chain.Spec, error) {
 func ProvideSimulationChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	specData.GenesisTime = 0
-	// Arbitrary number
-	specData.Deneb1ForkTime = 30
+	// Arbitrary number for the Deneb1 fork, the second fork of the registry.
+	specData.Forks[1].Timestamp = 30
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -110,8 +117,11 @@ func ProvideSimulationChainSpec() (chain.Spec, error) {
 func ProvidePectraForkTestChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 10
+	specData.Forks = []chain.ForkData{
+		{Name: "deneb", Version: version.Deneb(), Timestamp: 0},
+		{Name: "deneb1", Version: version.Deneb1(), Timestamp: 0},
+		{Name: "electra", Version: version.Electra(), Timestamp: 10},
+	}
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
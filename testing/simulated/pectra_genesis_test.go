@@ -31,12 +31,14 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/eip7002"
+	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/testing/simulated"
 	"github.com/berachain/beacon-kit/testing/simulated/execution"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/suite"
@@ -153,8 +155,59 @@ func (s *PectraGenesisSuite) TestFullLifecycle_WithRequests_IsSuccessful() {
 	blsSigner := simulated.GetBlsSigner(s.HomeDir)
 
 	// create withdrawal request
+	s.sendWithdrawalRequest(blsSigner.PublicKey(), 3456)
+
+	// Test happens post Electra fork.
+	startTime := time.Now()
+
+	// Go through iterations of the core loop.
+	s.LogBuffer.Reset()
+	proposals, _ := s.MoveChainToHeight(s.T(), blockHeight, coreLoopIterations, blsSigner, startTime)
+	s.Require().Len(proposals, coreLoopIterations)
+	// Log contains 1 withdrawal
+	s.Require().Contains(s.LogBuffer.String(), "Processing execution requests service=state-processor\u001B[0m deposits=0\u001B[0m withdrawals=1\u001B[0m consolidations=0\u001B[0m")
+}
+
+func (s *PectraGenesisSuite) TestFullLifecycle_WithPartialWithdrawalRequest_IsEnqueued() {
+	const blockHeight = 1
+	const coreLoopIterations = 10
+	const withdrawalAmount = math.Gwei(3456)
+
+	// Initialize the chain state.
+	s.InitializeChain(s.T())
+
+	// Retrieve the BLS signer and proposer address.
+	blsSigner := simulated.GetBlsSigner(s.HomeDir)
+
+	// The sender is the withdrawal address of the genesis validator.
+	s.sendWithdrawalRequest(blsSigner.PublicKey(), withdrawalAmount)
+
+	// Test happens post Electra fork.
+	startTime := time.Now()
+
+	// Go through iterations of the core loop.
+	proposals, _ := s.MoveChainToHeight(s.T(), blockHeight, coreLoopIterations, blsSigner, startTime)
+	s.Require().Len(proposals, coreLoopIterations)
+
+	currentHeight := int64(blockHeight + coreLoopIterations)
+	queryCtx, err := s.SimComet.CreateQueryContext(currentHeight-1, false)
+	s.Require().NoError(err)
+
+	// The partial withdrawal is enqueued, to be withdrawn after the next epoch.
+	stateDB := s.TestNode.StorageBackend.StateFromContext(queryCtx)
+	pending, err := stateDB.GetPendingPartialWithdrawals()
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Require().Equal(math.ValidatorIndex(0), pending[0].ValidatorIndex)
+	s.Require().Equal(withdrawalAmount, pending[0].Amount)
+	s.Require().Equal(math.Epoch(2), pending[0].WithdrawableEpoch)
+}
+
+// sendWithdrawalRequest submits an EIP-7002 withdrawal request for the given
+// validator from the address funded in genesis.
+func (s *PectraGenesisSuite) sendWithdrawalRequest(pubkey crypto.BLSPubkey, amount math.Gwei) {
 	// corresponds with funded address in genesis 0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4
-	senderKey, err := crypto.HexToECDSA("fffdbb37105441e14b0ee6330d855d8504ff39e705c3afa8f859ac9865f99306")
+	senderKey, err := gethcrypto.HexToECDSA("fffdbb37105441e14b0ee6330d855d8504ff39e705c3afa8f859ac9865f99306")
 	s.Require().NoError(err)
 
 	elChainID := big.NewInt(int64(s.TestNode.ChainSpec.DepositEth1ChainID()))
@@ -163,7 +216,7 @@ func (s *PectraGenesisSuite) TestFullLifecycle_WithRequests_IsSuccessful() {
 	fee, err := eip7002.GetWithdrawalFee(s.CtxApp, s.TestNode.EngineClient)
 	s.Require().NoError(err)
 
-	withdrawalTxData, err := eip7002.CreateWithdrawalRequestData(blsSigner.PublicKey(), 3456)
+	withdrawalTxData, err := eip7002.CreateWithdrawalRequestData(pubkey, amount)
 	s.Require().NoError(err)
 
 	withdrawalTx := types.MustSignNewTx(senderKey, signer, &types.DynamicFeeTx{
@@ -183,14 +236,4 @@ func (s *PectraGenesisSuite) TestFullLifecycle_WithRequests_IsSuccessful() {
 	var result interface{}
 	err = s.TestNode.EngineClient.Call(s.CtxApp, &result, "eth_sendRawTransaction", hexutil.Encode(txBytes))
 	s.Require().NoError(err)
-
-	// Test happens post Electra fork.
-	startTime := time.Now()
-
-	// Go through iterations of the core loop.
-	s.LogBuffer.Reset()
-	proposals, _ := s.MoveChainToHeight(s.T(), blockHeight, coreLoopIterations, blsSigner, startTime)
-	s.Require().Len(proposals, coreLoopIterations)
-	// Log contains 1 withdrawal
-	s.Require().Contains(s.LogBuffer.String(), "Processing execution requests service=state-processor\u001B[0m deposits=0\u001B[0m withdrawals=1\u001B[0m consolidations=0\u001B[0m")
 }
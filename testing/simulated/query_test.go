@@ -0,0 +1,109 @@
+//go:build simulated
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulated_test
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/testing/simulated"
+	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// TestQuery_BeaconState_IsProvenAgainstAppHash tests that the beacon ABCI queries return the committed beacon state,
+// with proofs that verify against the app hash.
+func (s *SimulatedSuite) TestQuery_BeaconState_IsProvenAgainstAppHash() {
+	const blockHeight = 1
+	const coreLoopIterations = 3
+
+	// Initialize the chain state.
+	s.InitializeChain(s.T())
+
+	// Retrieve the BLS signer and proposer pubkey.
+	blsSigner := simulated.GetBlsSigner(s.HomeDir)
+	pubkey := blsSigner.PublicKey()
+
+	proposals, _ := s.MoveChainToHeight(s.T(), blockHeight, coreLoopIterations, blsSigner, time.Now())
+	s.Require().Len(proposals, coreLoopIterations)
+
+	// The app hash of the last committed height is the root the proofs verify against.
+	info, err := s.SimComet.Comet.Info(s.CtxComet, &types.InfoRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(blockHeight+coreLoopIterations-1), info.LastBlockHeight)
+
+	// Read the expected values from the committed beacon state.
+	queryCtx, err := s.SimComet.CreateQueryContext(info.LastBlockHeight, false)
+	s.Require().NoError(err)
+	stateDB := s.TestNode.StorageBackend.StateFromContext(queryCtx)
+	idx, err := stateDB.ValidatorIndexByPubkey(pubkey)
+	s.Require().NoError(err)
+	val, err := stateDB.ValidatorByIndex(idx)
+	s.Require().NoError(err)
+	valBz, err := val.MarshalSSZ()
+	s.Require().NoError(err)
+	balance, err := stateDB.GetBalance(idx)
+	s.Require().NoError(err)
+	slot, err := stateDB.GetSlot()
+	s.Require().NoError(err)
+	stateRoot, err := stateDB.StateRootAtIndex((slot.Unwrap() - 1) % s.TestNode.ChainSpec.SlotsPerHistoricalRoot())
+	s.Require().NoError(err)
+	eth1Data, err := stateDB.GetEth1Data()
+	s.Require().NoError(err)
+	eth1DataBz, err := eth1Data.MarshalSSZ()
+	s.Require().NoError(err)
+
+	expectedValues := map[string][]byte{
+		"/beacon/validator/" + pubkey.String():                    valBz,
+		"/beacon/balance/" + strconv.FormatUint(idx.Unwrap(), 10): binary.BigEndian.AppendUint64(nil, balance.Unwrap()),
+		"/beacon/state_root":                                      stateRoot[:],
+		"/beacon/deposit_root":                                    eth1DataBz,
+	}
+
+	proofRuntime := rootmulti.DefaultProofRuntime()
+	for path, expected := range expectedValues {
+		resp, qErr := s.SimComet.Comet.Query(s.CtxComet, &types.QueryRequest{
+			Path:   path,
+			Height: info.LastBlockHeight,
+			Prove:  true,
+		})
+		s.Require().NoError(qErr)
+		s.Require().Zero(resp.Code, resp.Log)
+		s.Require().Equal(expected, resp.Value, path)
+
+		keyPath := merkle.KeyPath{}.
+			AppendKey([]byte(storage.StoreKey.Name()), merkle.KeyEncodingURL).
+			AppendKey(resp.Key, merkle.KeyEncodingHex)
+		s.Require().NoError(proofRuntime.VerifyValue(
+			resp.ProofOps, info.LastBlockAppHash, keyPath.String(), resp.Value,
+		), path)
+	}
+
+	// Unknown paths are rejected.
+	resp, err := s.SimComet.Comet.Query(s.CtxComet, &types.QueryRequest{Path: "/beacon/unknown"})
+	s.Require().NoError(err)
+	s.Require().NotZero(resp.Code)
+}
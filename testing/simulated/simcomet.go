@@ -27,12 +27,16 @@ import (
 
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -51,7 +55,9 @@ func ProvideSimComet(
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
-	telemetrySink *metrics.TelemetrySink) *SimComet {
+	telemetrySink *metrics.TelemetrySink,
+	storageBackend *storage.Backend,
+	chainSpec chain.Spec) *SimComet {
 	return &SimComet{
 		cometbft.NewService(
 			logger,
@@ -60,7 +66,10 @@ func ProvideSimComet(
 			blockBuilder,
 			cmtCfg,
 			telemetrySink,
-			builder.DefaultServiceOptions(appOpts)...,
+			append(
+				builder.DefaultServiceOptions(appOpts),
+				cometbft.SetStorageBackend(storageBackend, chainSpec),
+			)...,
 		)}
 }
 
@@ -82,5 +91,25 @@ func (s *SimComet) CreateQueryContext(height int64, prove bool) (sdk.Context, er
 }
 
 func (s *SimComet) LastBlockHeight() int64 {
-	panic("unimplemented")
+	return s.Comet.LastBlockHeight()
+}
+
+func (s *SimComet) NodeInfo() p2p.NodeInfo {
+	return s.Comet.NodeInfo()
+}
+
+func (s *SimComet) Peers() []p2p.Peer {
+	return s.Comet.Peers()
+}
+
+func (s *SimComet) IsCatchingUp() bool {
+	return s.Comet.IsCatchingUp()
+}
+
+func (s *SimComet) MaxPeerHeight() int64 {
+	return s.Comet.MaxPeerHeight()
+}
+
+func (s *SimComet) ProposerSchedule(start int64, count int) ([]crypto.BLSPubkey, error) {
+	return s.Comet.ProposerSchedule(start, count)
 }
@@ -113,6 +113,7 @@ func buildNode(
 		apiBackend      nodecomponents.NodeAPIBackend
 		beaconNode      nodetypes.Node
 		simComet        *SimComet
+		chainService    *blockchain.Service
 		config          *config.Config
 		storageBackend  blockchain.StorageBackend
 		chainSpec       chain.Spec
@@ -138,6 +139,7 @@ func buildNode(
 		&apiBackend,
 		&beaconNode,
 		&simComet,
+		&chainService,
 		&config,
 		&storageBackend,
 		&chainSpec,
@@ -157,6 +159,7 @@ func buildNode(
 
 	logger.WithConfig(config.GetLogger())
 	apiBackend.AttachQueryBackend(simComet)
+	chainService.AttachStateHistory(simComet)
 	return TestNode{
 		Node:            beaconNode,
 		StorageBackend:  storageBackend,
@@ -191,7 +194,7 @@ func getAppOptions(t *testing.T, appOpts *viper.Viper, beaconKitConfig *config.C
 	appOpts.Set(flags.PrivValidatorStateFile, "./data/priv_validator_state.json")
 
 	// Beacon Config
-	appOpts.Set(flags.BlockStoreServiceAvailabilityWindow, beaconKitConfig.GetBlockStoreService().AvailabilityWindow)
+	appOpts.Set(flags.BlockStoreServiceBlockRetention, beaconKitConfig.GetBlockStoreService().BlockRetention)
 	appOpts.Set(flags.BlockStoreServiceEnabled, beaconKitConfig.GetBlockStoreService().Enabled)
 	appOpts.Set(flags.KZGTrustedSetupPath, "../files/kzg-trusted-setup.json")
 	appOpts.Set(flags.KZGImplementation, kzg.DefaultConfig().Implementation)